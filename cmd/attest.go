@@ -0,0 +1,99 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/attest"
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+	"github.com/spf13/cobra"
+)
+
+var (
+	attestPath   string
+	attestOutput string
+)
+
+var attestCmd = &cobra.Command{
+	Use:   "attest [path]",
+	Short: "Emit an in-toto statement of baseline compliance",
+	Long: `Check a repository and emit an unsigned in-toto statement recording
+which OpenSSF baseline requirements it met at its current commit.
+
+The statement's subject is the repository and HEAD commit; the predicate holds
+the full check result. Sign it with your existing tooling (for example by
+wrapping it in a DSSE envelope) to feed provenance pipelines.
+
+Example:
+  baseline-init attest
+  baseline-init attest /path/to/repo -o baseline.intoto.json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAttest,
+}
+
+func init() {
+	rootCmd.AddCommand(attestCmd)
+
+	attestCmd.Flags().StringVarP(&attestPath, "path", "p", ".", "Path to repository")
+	attestCmd.Flags().StringVarP(&attestOutput, "output", "o", "", "Write the statement to a file instead of stdout")
+}
+
+func runAttest(cmd *cobra.Command, args []string) error {
+	// Determine repository path
+	repoPath := attestPath
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+
+	// Verify path exists
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		return fmt.Errorf("path does not exist: %s", repoPath)
+	}
+
+	commit, err := gitutil.HeadCommit(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to determine HEAD commit (is %s a git repository with commits?): %w", repoPath, err)
+	}
+
+	// Name the subject after the remote so statements from different clones match
+	subject := repoPath
+	if remote, err := gitutil.DetectRemote(repoPath); err == nil && remote != "" {
+		subject = gitutil.NormalizeURL(remote)
+	} else if absPath, err := filepath.Abs(repoPath); err == nil {
+		subject = absPath
+	}
+
+	result, err := checker.New(repoPath).CheckContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("compliance check failed: %w", err)
+	}
+	// Never sign off on a check that did not run to completion
+	if result.Partial {
+		return fmt.Errorf("compliance check timed out; no statement written")
+	}
+
+	statement := attest.New(result, subject, commit, Version, time.Now())
+	data, err := json.MarshalIndent(statement, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode statement: %w", err)
+	}
+	data = append(data, '\n')
+
+	if attestOutput == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(attestOutput, data, 0644); err != nil {
+		return fmt.Errorf("failed to write statement: %w", err)
+	}
+	fmt.Printf("✓ Wrote compliance statement to %s\n", attestOutput)
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/report"
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditOutputFormat string
+	auditPath         string
+	auditNoLegend     bool
+	auditSort         string
+	auditGroupBy      string
+	auditTeams        string
+	auditGitHubAPI    bool
+	auditFundingAdmin int
+	auditFields       []string
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit [path...]",
+	Short: "Report OpenSSF baseline compliance without failing",
+	Long: `Scan a repository and report its OpenSSF baseline compliance status.
+
+Unlike check, audit always exits with code 0 when the scan completes, even if
+the repository is not compliant. A scan cut short by --timeout exits with 124. Use it for dashboards and scheduled reports
+where a non-zero exit would fail the job; use check to gate CI.
+
+Example:
+  baseline-init audit
+  baseline-init audit /path/to/repo --format json
+  baseline-init audit repos/* --group-by score-bucket`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runAudit,
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().StringVarP(&auditOutputFormat, "format", "f", "text", "Output format (text, json, json-compact, yaml, or template=<go template>)")
+	auditCmd.Flags().StringVarP(&auditPath, "path", "p", ".", "Path to repository")
+	auditCmd.Flags().StringVar(&auditSort, "sort", "priority", "Order recommendations by priority, category, or effort (quick wins first)")
+	auditCmd.Flags().BoolVar(&auditNoLegend, "no-legend", false, "Omit the symbol and priority legend from text output")
+	auditCmd.Flags().StringVar(&auditGroupBy, "group-by", "", "Group multi-repository reports by status, team, or score-bucket")
+	auditCmd.Flags().StringVar(&auditTeams, "teams", "", "YAML file mapping team names to repository paths (for --group-by team)")
+	auditCmd.Flags().BoolVar(&auditGitHubAPI, "github-api", false, "Query the GitHub API for repository state such as archival (uses GITHUB_TOKEN if set)")
+	auditCmd.Flags().IntVar(&auditFundingAdmin, "funding-admin-threshold", checker.DefaultFundingAdminThreshold,
+		"Administrator count from which active projects are advised to declare funding (0 disables)")
+	auditCmd.Flags().StringSliceVar(&auditFields, "fields", nil, "Limit JSON output to these result fields, e.g. path,is_compliant,score")
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		if len(auditFields) > 0 {
+			return fmt.Errorf("--fields is only supported when auditing a single repository")
+		}
+		results, err := checkRepositories(cmd.Context(), args, checkOptions{githubAPI: auditGitHubAPI, fundingAdminThreshold: auditFundingAdmin})
+		if err != nil {
+			return err
+		}
+
+		reporter, err := newMultiReporter(auditOutputFormat, auditSort, auditGroupBy, auditTeams)
+		if err != nil {
+			return err
+		}
+		reporter.SetShowLegend(!auditNoLegend)
+		if err := reporter.OutputMultiResult(results); err != nil {
+			return fmt.Errorf("failed to output results: %w", err)
+		}
+		if anyPartial(results) {
+			return exitWith(cmd, exitPartial)
+		}
+		return nil
+	}
+
+	// Determine repository path
+	repoPath := auditPath
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+
+	// Verify path exists
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		return fmt.Errorf("path does not exist: %s", repoPath)
+	}
+
+	// Run compliance check
+	c, err := newChecker(repoPath, checkOptions{githubAPI: auditGitHubAPI, fundingAdminThreshold: auditFundingAdmin})
+	if err != nil {
+		return err
+	}
+	result, err := c.CheckContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("compliance check failed: %w", err)
+	}
+
+	// Report only; compliance never affects the exit code, but a scan cut
+	// short by --timeout does
+	reporter := report.NewReporter(auditOutputFormat)
+	reporter.SetShowLegend(!auditNoLegend)
+	reporter.SetSort(auditSort)
+	reporter.SetFields(auditFields)
+	if err := reporter.OutputCheckResult(result); err != nil {
+		return fmt.Errorf("failed to output results: %w", err)
+	}
+	if result.Partial {
+		return exitWith(cmd, exitPartial)
+	}
+
+	return nil
+}
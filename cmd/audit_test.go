@@ -0,0 +1,138 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestAudit_NeverFails(t *testing.T) {
+	compliant := writeCompliantRepo(t)
+	empty := t.TempDir()
+
+	tests := []struct {
+		name   string
+		args   []string
+		format string
+		want   []string
+	}{
+		{name: "not compliant", args: []string{empty}, format: "text", want: []string{"NOT COMPLIANT"}},
+		{name: "compliant", args: []string{compliant}, format: "text", want: []string{"✓ COMPLIANT"}},
+		{name: "not compliant json", args: []string{empty}, format: "json", want: []string{`"is_compliant": false`}},
+		{name: "several repositories", args: []string{compliant, empty}, format: "text", want: []string{"Repositories: 2 (1 compliant, 1 not compliant)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditOutputFormat = tt.format
+			t.Cleanup(func() { auditOutputFormat = "text" })
+			auditCmd.SetContext(context.Background())
+
+			output, err := captureStdout(t, func() error { return auditCmd.RunE(auditCmd, tt.args) })
+			if err != nil {
+				t.Fatalf("RunE() error = %v, want nil whatever the compliance", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output does not contain %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+func TestAudit_Fields(t *testing.T) {
+	repo := t.TempDir()
+	auditOutputFormat, auditFields = "json-compact", []string{"path", "is_compliant"}
+	t.Cleanup(func() { auditOutputFormat, auditFields = "text", nil })
+	auditCmd.SetContext(context.Background())
+
+	output, err := captureStdout(t, func() error { return auditCmd.RunE(auditCmd, []string{repo}) })
+	if err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, output)
+	}
+	if len(result) != 2 || result["path"] != repo || result["is_compliant"] != false {
+		t.Errorf("output = %v, want only path and is_compliant", result)
+	}
+
+	// Field selection needs a single repository
+	if err := auditCmd.RunE(auditCmd, []string{repo, t.TempDir()}); err == nil || !strings.Contains(err.Error(), "--fields") {
+		t.Errorf("RunE() with several repositories error = %v, want a --fields error", err)
+	}
+}
+
+func TestAudit_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		canceled bool
+		wantCode int
+		wantErr  string
+	}{
+		{name: "missing path", args: []string{"does-not-exist"}, wantErr: "path does not exist"},
+		{name: "cut short", args: []string{t.TempDir()}, canceled: true, wantCode: exitPartial},
+		{name: "several cut short", args: []string{t.TempDir(), t.TempDir()}, canceled: true, wantCode: exitPartial},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.canceled {
+				cancel()
+			}
+			defer cancel()
+			auditCmd.SetContext(ctx)
+			t.Cleanup(func() { auditCmd.SetContext(context.Background()) })
+
+			_, err := captureStdout(t, func() error { return auditCmd.RunE(auditCmd, tt.args) })
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("RunE() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			var exitErr *ExitError
+			if !errors.As(err, &exitErr) || exitErr.Code != tt.wantCode {
+				t.Errorf("RunE() error = %v, want exit code %d", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAudit_MatchesCheck(t *testing.T) {
+	// audit reports what check would, only without the exit code
+	repo := t.TempDir()
+	auditOutputFormat = "json"
+	t.Cleanup(func() { auditOutputFormat = "text" })
+	auditCmd.SetContext(context.Background())
+
+	output, err := captureStdout(t, func() error { return auditCmd.RunE(auditCmd, []string{repo}) })
+	if err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	var audited checker.CheckResult
+	if err := json.Unmarshal([]byte(output), &audited); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+
+	checked, err := checker.New(repo).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if audited.IsCompliant != checked.IsCompliant || audited.Score != checked.Score ||
+		strings.Join(audited.MissingFiles, ",") != strings.Join(checked.MissingFiles, ",") {
+		t.Errorf("audit = compliant %v, score %d, missing %v; check = compliant %v, score %d, missing %v",
+			audited.IsCompliant, audited.Score, audited.MissingFiles, checked.IsCompliant, checked.Score, checked.MissingFiles)
+	}
+}
@@ -4,10 +4,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/config"
+	"github.com/aguamala/baseline-init/pkg/github"
+	"github.com/aguamala/baseline-init/pkg/progress"
 	"github.com/aguamala/baseline-init/pkg/report"
 	"github.com/spf13/cobra"
 )
@@ -15,10 +22,32 @@ import (
 var (
 	checkOutputFormat string
 	checkPath         string
+	checkNoLegend     bool
+	checkOnlyMissing  bool
+	checkSort         string
+	checkGroupBy      string
+	checkTeams        string
+	checkGitHubAPI    bool
+	checkFundingAdmin int
+	checkFields       []string
+	checkOutput       string
+	checkFailOn       string
+	checkStrict       bool
+	checkLevel        int
+	checkRecursive    bool
+	checkMaxDepth     int
+	checkRemote       string
+	checkConfig       string
+	checkOnly         []string
+	checkSkip         []string
 )
 
+// failOnLevels lists the --fail-on thresholds from most to least severe.
+// none never fails.
+var failOnLevels = []string{"critical", "high", "medium", "low", "none"}
+
 var checkCmd = &cobra.Command{
-	Use:   "check [path]",
+	Use:   "check [path...]",
 	Short: "Check repository for OpenSSF baseline compliance",
 	Long: `Scan a repository and identify missing or invalid OpenSSF baseline
 compliance requirements.
@@ -33,19 +62,91 @@ Example:
   baseline-init check
   baseline-init check /path/to/repo
   baseline-init check --format json
-  baseline-init check --format yaml`,
-	Args: cobra.MaximumNArgs(1),
+  baseline-init check --format json-compact
+  baseline-init check --format json -o reports/compliance.json
+  baseline-init check --format yaml
+  baseline-init check --format sarif > baseline.sarif
+  baseline-init check --format github  # annotations in a GitHub Actions step
+  baseline-init check --format markdown
+  baseline-init check --format 'template={{.IsCompliant}}:{{.Score}}'
+  baseline-init check --only-missing
+  baseline-init check --sort effort
+  baseline-init check --fail-on medium
+  baseline-init check --strict
+  baseline-init check --level 1
+  baseline-init check repos/* --group-by status
+  baseline-init check --recursive --max-depth 3
+  baseline-init check --remote github.com/owner/repo
+  baseline-init check repos/* --group-by team --teams teams.yaml`,
+	Args: cobra.ArbitraryArgs,
 	RunE: runCheck,
 }
 
 func init() {
 	rootCmd.AddCommand(checkCmd)
 
-	checkCmd.Flags().StringVarP(&checkOutputFormat, "format", "f", "text", "Output format (text, json, yaml)")
+	checkCmd.Flags().StringVarP(&checkOutputFormat, "format", "f", "text", "Output format (text, json, json-compact, yaml, markdown (md), sarif, github, or template=<go template>)")
 	checkCmd.Flags().StringVarP(&checkPath, "path", "p", ".", "Path to repository")
+	checkCmd.Flags().BoolVar(&checkNoLegend, "no-legend", false, "Omit the symbol and priority legend from text output")
+	checkCmd.Flags().StringVar(&checkSort, "sort", "priority", "Order recommendations by priority, category, or effort (quick wins first)")
+	checkCmd.Flags().BoolVar(&checkOnlyMissing, "only-missing", false, "Show only missing files and the recommendations for them")
+	checkCmd.Flags().StringVar(&checkGroupBy, "group-by", "", "Group multi-repository reports by status, team, or score-bucket")
+	checkCmd.Flags().StringVar(&checkTeams, "teams", "", "YAML file mapping team names to repository paths (for --group-by team)")
+	checkCmd.Flags().BoolVar(&checkGitHubAPI, "github-api", false, "Query the GitHub API for repository state such as archival (uses GITHUB_TOKEN if set)")
+	checkCmd.Flags().IntVar(&checkFundingAdmin, "funding-admin-threshold", checker.DefaultFundingAdminThreshold,
+		"Administrator count from which active projects are advised to declare funding (0 disables)")
+	checkCmd.Flags().StringSliceVar(&checkFields, "fields", nil, "Limit JSON output to these result fields, e.g. path,is_compliant,score")
+	checkCmd.Flags().StringVarP(&checkOutput, "output", "o", "", "Write the report to this file instead of stdout, creating parent directories as needed")
+	checkCmd.Flags().BoolVarP(&checkRecursive, "recursive", "r", false, "Also check each project below the path, found by markers such as go.mod, package.json or .git")
+	checkCmd.Flags().IntVar(&checkMaxDepth, "max-depth", 0, "With --recursive, search at most this many directory levels deep (0 for no limit)")
+	checkCmd.Flags().StringVar(&checkRemote, "remote", "", "Check a GitHub repository such as github.com/owner/repo through the API instead of a local path (uses GITHUB_TOKEN if set)")
+	checkCmd.MarkFlagsMutuallyExclusive("remote", "recursive")
+	checkCmd.Flags().StringVar(&checkFailOn, "fail-on", "high", "Exit non-zero when a recommendation has this priority or higher (critical, high, medium, low, or none)")
+	checkCmd.Flags().IntVar(&checkLevel, "level", checker.MaxLevel, "Only check the requirements of OpenSSF Baseline maturity levels up to this one (1, 2 or 3)")
+	checkCmd.Flags().BoolVar(&checkStrict, "strict", false, "Treat file warnings as errors, exiting non-zero if there are any")
+	checkCmd.Flags().StringSliceVar(&checkOnly, "only", nil, "Run only the checks with these IDs, e.g. license,security-policy (see list-checks)")
+	checkCmd.Flags().StringSliceVar(&checkSkip, "skip", nil, "Skip the checks with these IDs, e.g. code-of-conduct,tracking (see list-checks)")
+	checkCmd.Flags().StringVar(&checkConfig, "config", "", "Config file defining which files are required and their priorities (default: the nearest "+config.FileName+" up the directory tree)")
 }
 
 func runCheck(cmd *cobra.Command, args []string) error {
+	if !slices.Contains(failOnLevels, checkFailOn) {
+		return fmt.Errorf("invalid --fail-on %q: must be one of %s", checkFailOn, strings.Join(failOnLevels, ", "))
+	}
+	if !slices.Contains(report.SortOrders, checkSort) {
+		return fmt.Errorf("invalid --sort %q: must be one of %s", checkSort, strings.Join(report.SortOrders, ", "))
+	}
+	if checkLevel < checker.MinLevel || checkLevel > checker.MaxLevel {
+		return fmt.Errorf("invalid --level %d: must be between %d and %d", checkLevel, checker.MinLevel, checker.MaxLevel)
+	}
+	// Checked before the output file is opened, which truncates it
+	formats := report.Formats
+	if len(args) > 1 || checkRecursive {
+		formats = report.MultiFormats
+	}
+	if err := report.ValidateFormat(checkOutputFormat, formats); err != nil {
+		return fmt.Errorf("invalid --format: %w", err)
+	}
+	if len(checkFields) > 0 && (len(args) > 1 || checkRecursive) {
+		return fmt.Errorf("--fields is only supported when checking a single repository")
+	}
+	if checkRemote != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("--remote does not take a path")
+		}
+		return runCheckRemote(cmd)
+	}
+	if len(args) > 1 {
+		if checkRecursive {
+			return fmt.Errorf("--recursive takes a single path")
+		}
+		results, err := checkRepositories(cmd.Context(), args, checkOptions{githubAPI: checkGitHubAPI, fundingAdminThreshold: checkFundingAdmin, level: checkLevel, configPath: checkConfig, only: checkOnly, skip: checkSkip})
+		if err != nil {
+			return err
+		}
+		return outputCheckMulti(cmd, results, false)
+	}
+
 	// Determine repository path
 	repoPath := checkPath
 	if len(args) > 0 {
@@ -58,22 +159,303 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	}
 
 	// Run compliance check
-	c := checker.New(repoPath)
-	result, err := c.Check()
+	c, err := newChecker(repoPath, checkOptions{githubAPI: checkGitHubAPI, fundingAdminThreshold: checkFundingAdmin, level: checkLevel, configPath: checkConfig, only: checkOnly, skip: checkSkip})
+	if err != nil {
+		return err
+	}
+	if checkRecursive {
+		// The number of projects is only known once they are found
+		var bar *progress.Bar
+		c.SetProgress(func(done, total int, path string) {
+			if bar == nil {
+				bar = progress.New(total, quiet)
+			}
+			bar.Increment(filepath.Base(path))
+		})
+		multi, err := c.CheckRecursiveContext(cmd.Context(), checkMaxDepth)
+		if bar != nil {
+			bar.Finish()
+		}
+		if err != nil {
+			return fmt.Errorf("compliance check failed: %w", err)
+		}
+		// Warnings go to stderr so the report can be redirected to a file
+		printWarnings(os.Stderr, multi.Warnings)
+		return outputCheckMulti(cmd, multi.Projects, multi.Partial)
+	}
+	result, err := c.CheckContext(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("compliance check failed: %w", err)
 	}
 
+	return outputCheck(cmd, result)
+}
+
+// runCheckRemote checks the --remote repository through the GitHub API
+func runCheckRemote(cmd *cobra.Command) error {
+	owner, name, ok := github.ParseRepoURL(checkRemote)
+	if !ok {
+		return fmt.Errorf("--remote must name a GitHub repository, such as github.com/owner/repo: %s", checkRemote)
+	}
+
+	c, err := newChecker("", checkOptions{githubAPI: true, fundingAdminThreshold: checkFundingAdmin, level: checkLevel, configPath: checkConfig, only: checkOnly, skip: checkSkip})
+	if err != nil {
+		return err
+	}
+	result, err := c.CheckRemote(cmd.Context(), owner, name)
+	if err != nil {
+		return fmt.Errorf("compliance check failed: %w", err)
+	}
+	return outputCheck(cmd, result)
+}
+
+// outputCheck reports a single check result and sets the exit code
+func outputCheck(cmd *cobra.Command, result *checker.CheckResult) error {
+	promoted := checkStrict && result.PromoteWarnings()
+
 	// Format and output results
+	out, err := openOutput(checkOutput)
+	if err != nil {
+		return err
+	}
 	reporter := report.NewReporter(checkOutputFormat)
-	if err := reporter.OutputCheckResult(result); err != nil {
+	reporter.SetOutput(out)
+	reporter.SetShowLegend(!checkNoLegend)
+	reporter.SetOnlyMissing(checkOnlyMissing)
+	reporter.SetSort(checkSort)
+	reporter.SetFields(checkFields)
+	err = reporter.OutputCheckResult(result)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
 		return fmt.Errorf("failed to output results: %w", err)
 	}
+	if err := writeStepSummary(result); err != nil {
+		return err
+	}
 
-	// Exit with error code if not compliant
-	if !result.IsCompliant {
-		os.Exit(1)
+	// Exit with error code if cut short, over the --fail-on threshold, or
+	// there were warnings under --strict
+	if code := checkExitCode(result, checkFailOn); code != 0 {
+		return exitWith(cmd, code)
+	}
+	if promoted {
+		return exitWith(cmd, 1)
+	}
+
+	return nil
+}
+
+// outputCheckMulti reports several repositories, or the projects of a
+// monorepo, together. partial marks a run cut short before every result was
+// produced.
+func outputCheckMulti(cmd *cobra.Command, results []*checker.CheckResult, partial bool) error {
+	reporter, err := newMultiReporter(checkOutputFormat, checkSort, checkGroupBy, checkTeams)
+	if err != nil {
+		return err
+	}
+	out, err := openOutput(checkOutput)
+	if err != nil {
+		return err
+	}
+	reporter.SetOutput(out)
+	reporter.SetShowLegend(!checkNoLegend)
+	reporter.SetOnlyMissing(checkOnlyMissing)
+	promoted := false
+	if checkStrict {
+		for _, result := range results {
+			if result.PromoteWarnings() {
+				promoted = true
+			}
+		}
+	}
+	err = reporter.OutputMultiResult(results)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to output results: %w", err)
+	}
+	if err := writeStepSummary(results...); err != nil {
+		return err
 	}
 
+	// Exit with error code if cut short, any repository is over the
+	// --fail-on threshold, or there were warnings under --strict
+	if partial || anyPartial(results) {
+		return exitWith(cmd, exitPartial)
+	}
+	for _, result := range results {
+		if code := checkExitCode(result, checkFailOn); code != 0 {
+			return exitWith(cmd, code)
+		}
+	}
+	if promoted {
+		return exitWith(cmd, 1)
+	}
+
+	return nil
+}
+
+// writeStepSummary appends the Markdown report of each result to the file
+// named by GITHUB_STEP_SUMMARY, which GitHub Actions renders on the job page.
+// Outside Actions the variable is unset and nothing is written. Earlier steps
+// may have written to the file, so it is appended to, never truncated.
+func writeStepSummary(results ...*checker.CheckResult) error {
+	path := os.Getenv("GITHUB_STEP_SUMMARY")
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open step summary: %w", err)
+	}
+	reporter := report.NewReporter("markdown")
+	reporter.SetOutput(f)
+	reporter.SetSort(checkSort)
+	for _, result := range results {
+		// A blank line keeps the report from running into earlier content
+		if info, statErr := f.Stat(); statErr == nil && info.Size() > 0 {
+			if _, err = fmt.Fprintln(f); err != nil {
+				break
+			}
+		}
+		if err = reporter.OutputCheckResult(result); err != nil {
+			break
+		}
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write step summary: %w", err)
+	}
 	return nil
 }
+
+// checkExitCode returns the exit code for a check result: exitPartial if it
+// was cut short, 0 under failOn "none", 1 if it is not compliant or a
+// recommendation is at or above the failOn priority, and 0 otherwise
+func checkExitCode(result *checker.CheckResult, failOn string) int {
+	if result.Partial {
+		return exitPartial
+	}
+	if failOn == "none" {
+		return 0
+	}
+	if !result.IsCompliant {
+		return 1
+	}
+
+	threshold := slices.Index(failOnLevels, failOn)
+	for _, rec := range result.Recommendations {
+		if level := slices.Index(failOnLevels, rec.Priority); level >= 0 && level <= threshold {
+			return 1
+		}
+	}
+	return 0
+}
+
+// checkOptions carries the checker settings shared by check and audit
+type checkOptions struct {
+	githubAPI             bool
+	fundingAdminThreshold int
+	level                 int
+	// configPath is the config file to apply; when empty, the repository's
+	// own config.FileName is looked for up the directory tree
+	configPath string
+	// only and skip select the file checks to run by ID
+	only, skip []string
+}
+
+// newChecker creates a checker configured from opts
+func newChecker(repoPath string, opts checkOptions) (*checker.Checker, error) {
+	c := checker.New(repoPath)
+	if opts.githubAPI {
+		c.SetGitHubClient(github.NewClient())
+	}
+	c.SetFundingAdminThreshold(opts.fundingAdminThreshold)
+	if opts.level > 0 {
+		c.SetLevel(opts.level)
+	}
+
+	configPath := opts.configPath
+	if configPath == "" && repoPath != "" {
+		found, err := config.Find(repoPath)
+		if err != nil {
+			return nil, err
+		}
+		configPath = found
+	}
+	if configPath != "" {
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.SetConfig(cfg); err != nil {
+			return nil, fmt.Errorf("%s: %w", configPath, err)
+		}
+	}
+	if err := c.SelectChecks(opts.only, opts.skip); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkRepositories runs the compliance check against each path in turn.
+// Once ctx is done, remaining repositories are reported as partial without
+// being checked.
+func checkRepositories(ctx context.Context, paths []string, opts checkOptions) ([]*checker.CheckResult, error) {
+	bar := progress.New(len(paths), quiet)
+	defer bar.Finish()
+
+	var results []*checker.CheckResult
+	for _, repoPath := range paths {
+		if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+			return nil, fmt.Errorf("path does not exist: %s", repoPath)
+		}
+
+		c, err := newChecker(repoPath, opts)
+		if err != nil {
+			return nil, err
+		}
+		result, err := c.CheckContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("compliance check failed for %s: %w", repoPath, err)
+		}
+		results = append(results, result)
+		bar.Increment(filepath.Base(repoPath))
+	}
+	return results, nil
+}
+
+// anyPartial reports whether any result was cut short
+func anyPartial(results []*checker.CheckResult) bool {
+	for _, result := range results {
+		if result.Partial {
+			return true
+		}
+	}
+	return false
+}
+
+// newMultiReporter builds a reporter for multi-repository output
+func newMultiReporter(format, sortBy, groupBy, teamsFile string) (*report.Reporter, error) {
+	reporter := report.NewReporter(format)
+	reporter.SetSort(sortBy)
+	reporter.SetGroupBy(groupBy)
+
+	if teamsFile != "" {
+		teams, err := report.LoadTeams(teamsFile)
+		if err != nil {
+			return nil, err
+		}
+		reporter.SetTeams(teams)
+	} else if groupBy == "team" {
+		return nil, fmt.Errorf("--group-by team requires --teams")
+	}
+
+	return reporter, nil
+}
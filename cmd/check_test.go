@@ -0,0 +1,447 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/config"
+	"github.com/aguamala/baseline-init/pkg/license"
+)
+
+// TestMain keeps tests run inside GitHub Actions from appending their reports
+// to the job's real step summary
+func TestMain(m *testing.M) {
+	os.Unsetenv("GITHUB_STEP_SUMMARY")
+	os.Exit(m.Run())
+}
+
+// writeRepo creates a repository in a temp directory with the given files
+func writeRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	repo := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return repo
+}
+
+// writeCompliantRepo creates a compliant repository with every checked file
+// but CONTRIBUTING.md, a low-priority file, so that its only recommendations
+// are low-priority ones
+func writeCompliantRepo(t *testing.T) string {
+	t.Helper()
+	mit, _ := license.Text("MIT")
+	return writeRepo(t, map[string]string{
+		"SECURITY-INSIGHTS.yml": `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`,
+		"SECURITY.md": `# Security Policy
+
+## Reporting a Vulnerability
+
+Email security@example.com. We respond within 48 hours.
+`,
+		"LICENSE":            mit,
+		"CODE_OF_CONDUCT.md": "# Code of Conduct\n",
+		"GOVERNANCE.md":      "# Governance\n",
+		"CODEOWNERS":         "* @maintainer\n",
+		"renovate.json":      "{}\n",
+	})
+}
+
+func TestCheck_FailOn(t *testing.T) {
+	repo := writeCompliantRepo(t)
+	result, err := checker.New(repo).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, rec := range result.Recommendations {
+		if rec.Priority != "low" {
+			t.Fatalf("unexpected %s recommendation: %s", rec.Priority, rec.Description)
+		}
+	}
+
+	tests := []struct {
+		failOn string
+		want   int
+	}{
+		{failOn: "critical", want: 0},
+		{failOn: "high", want: 0},
+		{failOn: "medium", want: 0},
+		{failOn: "low", want: 1},
+		{failOn: "none", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.failOn, func(t *testing.T) {
+			if got := checkExitCode(result, tt.failOn); got != tt.want {
+				t.Errorf("checkExitCode(--fail-on %s) = %d, want %d", tt.failOn, got, tt.want)
+			}
+		})
+	}
+
+	partial := *result
+	partial.Partial = true
+	if got := checkExitCode(&partial, "none"); got != exitPartial {
+		t.Errorf("checkExitCode(partial) = %d, want %d", got, exitPartial)
+	}
+
+	// A missing required file fails the check at every threshold but none
+	if err := os.Remove(filepath.Join(repo, "SECURITY.md")); err != nil {
+		t.Fatal(err)
+	}
+	result, err = checker.New(repo).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if result.IsCompliant {
+		t.Fatal("Check() without SECURITY.md is compliant")
+	}
+
+	nonCompliant := []struct {
+		failOn string
+		want   int
+	}{
+		{failOn: "critical", want: 1},
+		{failOn: "high", want: 1},
+		{failOn: "medium", want: 1},
+		{failOn: "low", want: 1},
+		{failOn: "none", want: 0},
+	}
+
+	for _, tt := range nonCompliant {
+		t.Run("non-compliant "+tt.failOn, func(t *testing.T) {
+			if got := checkExitCode(result, tt.failOn); got != tt.want {
+				t.Errorf("checkExitCode(--fail-on %s) without SECURITY.md = %d, want %d", tt.failOn, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck_UncommittedSetup(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	// setup's next steps say to run check before committing the files
+	mit, _ := license.Text("MIT")
+	repo := writeRepo(t, map[string]string{"LICENSE": mit})
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"remote", "add", "origin", "https://github.com/example/repo"},
+	} {
+		git := exec.Command("git", args...)
+		git.Dir = repo
+		if out, err := git.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v (%s)", args, err, out)
+		}
+	}
+	setupAuto = true
+	t.Cleanup(func() { setupAuto, setupInteractive = false, false })
+	if _, err := captureStdout(t, func() error { return runSetup(setupCmd, []string{repo}) }); err != nil {
+		t.Fatalf("runSetup() error = %v", err)
+	}
+
+	result, err := checker.New(repo).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	untracked := 0
+	for _, rec := range result.Recommendations {
+		if rec.ID == checker.RecFileUntracked {
+			untracked++
+		}
+	}
+	if untracked == 0 {
+		t.Fatalf("no uncommitted file recommendation in %v", result.Recommendations)
+	}
+	if got := checkExitCode(result, "high"); got != 0 {
+		t.Errorf("checkExitCode(--fail-on high) = %d, want 0 for uncommitted generated files", got)
+	}
+}
+
+func TestCheck_ConfigExitCode(t *testing.T) {
+	repo := writeCompliantRepo(t)
+	checkOutput = filepath.Join(t.TempDir(), "report.txt")
+	t.Cleanup(func() { checkOutput = "" })
+	checkCmd.SetContext(context.Background())
+
+	if err := checkCmd.RunE(checkCmd, []string{repo}); err != nil {
+		t.Fatalf("RunE() error = %v, want nil with only low-priority findings", err)
+	}
+
+	// Raising CONTRIBUTING.md to high puts its recommendation over the
+	// default --fail-on threshold
+	content := "files:\n  CONTRIBUTING.md:\n    priority: high\n"
+	if err := os.WriteFile(filepath.Join(repo, config.FileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err := checkCmd.RunE(checkCmd, []string{repo})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("RunE() error = %v, want exit code 1 with the config", err)
+	}
+}
+
+func TestCheck_FailOnInvalid(t *testing.T) {
+	rootCmd.SetArgs([]string{"check", t.TempDir(), "--fail-on", "severe"})
+	t.Cleanup(func() { checkFailOn = "high" })
+	if err := rootCmd.Execute(); err == nil {
+		t.Error("check accepted an unknown --fail-on value")
+	}
+}
+
+func TestCheck_LevelInvalid(t *testing.T) {
+	rootCmd.SetArgs([]string{"check", t.TempDir(), "--level", "4"})
+	t.Cleanup(func() { checkLevel = checker.MaxLevel })
+	if err := rootCmd.Execute(); err == nil {
+		t.Error("check accepted --level 4")
+	}
+}
+
+func TestCheck_OnlySkip(t *testing.T) {
+	mit, _ := license.Text("MIT")
+	repo := writeRepo(t, map[string]string{"LICENSE": mit})
+	checkOutput = filepath.Join(t.TempDir(), "report.json")
+	checkOutputFormat = "json"
+	t.Cleanup(func() { checkOutput, checkOutputFormat, checkOnly, checkSkip = "", "text", nil, nil })
+	checkCmd.SetContext(context.Background())
+
+	run := func() *checker.CheckResult {
+		t.Helper()
+		if err := checkCmd.RunE(checkCmd, []string{repo}); err != nil {
+			var exitErr *ExitError
+			if !errors.As(err, &exitErr) {
+				t.Fatalf("RunE() error = %v", err)
+			}
+		}
+		data, err := os.ReadFile(checkOutput)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var result checker.CheckResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			t.Fatalf("invalid report: %v", err)
+		}
+		return &result
+	}
+
+	checkOnly = []string{"license"}
+	result := run()
+	if len(result.Files) != 1 || result.Files[0].Name != "LICENSE" {
+		t.Errorf("--only license checked %v, want only LICENSE", result.Files)
+	}
+	if len(result.MissingFiles) != 0 || !result.IsCompliant {
+		t.Errorf("--only license: missing %v, compliant %v; want compliant", result.MissingFiles, result.IsCompliant)
+	}
+
+	checkOnly = nil
+	for _, def := range checker.Checks {
+		if def.ID != "license" {
+			checkSkip = append(checkSkip, def.ID)
+		}
+	}
+	result = run()
+	if len(result.Files) != 1 || !result.IsCompliant {
+		t.Errorf("--skip left %v, compliant %v; want only LICENSE, compliant", result.Files, result.IsCompliant)
+	}
+	for _, rec := range result.Recommendations {
+		if rec.File != "" && rec.File != "LICENSE" {
+			t.Errorf("recommendation for skipped %s: %s", rec.File, rec.Description)
+		}
+	}
+
+	checkSkip = []string{"licence"}
+	err := checkCmd.RunE(checkCmd, []string{repo})
+	if err == nil || !strings.Contains(err.Error(), `unknown check ID "licence"`) {
+		t.Errorf("RunE() error = %v, want an unknown check ID error", err)
+	}
+}
+
+func TestCheck_StepSummary(t *testing.T) {
+	summary := filepath.Join(t.TempDir(), "summary.md")
+	earlier := "## Tests\n\nAll tests passed.\n"
+	if err := os.WriteFile(summary, []byte(earlier), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GITHUB_STEP_SUMMARY", summary)
+
+	repo := t.TempDir()
+	checkOutput = filepath.Join(t.TempDir(), "report.txt")
+	checkFailOn = "none"
+	t.Cleanup(func() { checkOutput, checkFailOn = "", "high" })
+	checkCmd.SetContext(context.Background())
+
+	// The empty repository is not compliant, but --fail-on none never fails
+	if err := checkCmd.RunE(checkCmd, []string{repo}); err != nil {
+		t.Fatalf("RunE() error = %v, want nil under --fail-on none", err)
+	}
+
+	data, err := os.ReadFile(summary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	if !strings.HasPrefix(content, earlier+"\n") {
+		t.Errorf("step summary lost the earlier content:\n%s", content)
+	}
+	if !strings.Contains(content, "| Name | Exists | Valid | Location |") || !strings.Contains(content, "| SECURITY.md |") {
+		t.Errorf("step summary has no Markdown file table:\n%s", content)
+	}
+
+	// Without the variable nothing is written
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+	if err := checkCmd.RunE(checkCmd, []string{repo}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if after, _ := os.ReadFile(summary); len(after) != len(data) {
+		t.Errorf("step summary written without GITHUB_STEP_SUMMARY")
+	}
+}
+
+func TestCheck_RunEReturnsExitError(t *testing.T) {
+	repo := t.TempDir()
+	checkOutput = filepath.Join(t.TempDir(), "report.txt")
+	t.Cleanup(func() { checkOutput = "" })
+	checkCmd.SetContext(context.Background())
+
+	err := checkCmd.RunE(checkCmd, []string{repo})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("RunE() error = %v, want an *ExitError", err)
+	}
+	if exitErr.Code != 1 {
+		t.Errorf("exit code = %d, want 1", exitErr.Code)
+	}
+}
+
+func TestValidate_RunEReturnsExitError(t *testing.T) {
+	repo := writeRepo(t, map[string]string{"SECURITY-INSIGHTS.yml": "header:\n  schema-version: 2.0.0\n"})
+	validateOutput = filepath.Join(t.TempDir(), "report.txt")
+	t.Cleanup(func() { validateOutput = "" })
+
+	err := validateCmd.RunE(validateCmd, []string{filepath.Join(repo, "SECURITY-INSIGHTS.yml")})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("RunE() error = %v, want exit code 1", err)
+	}
+}
+
+func TestCheck_Strict(t *testing.T) {
+	// A compliant repository whose insights file is long past review has
+	// warnings but, with --fail-on none, no failing recommendation
+	mit, _ := license.Text("MIT")
+	repo := writeRepo(t, map[string]string{
+		"SECURITY-INSIGHTS.yml": `header:
+  schema-version: 2.0.0
+  last-updated: '2020-01-01'
+  last-reviewed: '2020-01-01'
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`,
+		"SECURITY.md": "# Security Policy\n\nEmail security@example.com.\n",
+		"LICENSE":     mit,
+	})
+	checkOutput = filepath.Join(t.TempDir(), "report.json")
+	checkOutputFormat = "json"
+	checkFailOn = "none"
+	t.Cleanup(func() { checkOutput, checkOutputFormat, checkFailOn, checkStrict = "", "text", "high", false })
+	checkCmd.SetContext(context.Background())
+	readReport := func() checker.CheckResult {
+		t.Helper()
+		var result checker.CheckResult
+		data, _ := os.ReadFile(checkOutput)
+		if err := json.Unmarshal(data, &result); err != nil {
+			t.Fatalf("invalid report: %v", err)
+		}
+		return result
+	}
+
+	if err := checkCmd.RunE(checkCmd, []string{repo}); err != nil {
+		t.Fatalf("RunE() error = %v, want nil without --strict", err)
+	}
+	lenient := readReport()
+
+	checkStrict = true
+	err := checkCmd.RunE(checkCmd, []string{repo})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("RunE() error = %v, want exit code 1 under --strict", err)
+	}
+	strict := readReport()
+	if si := strict.Files[0]; len(si.Errors) == 0 || !strings.HasPrefix(si.Errors[0], "warning: ") {
+		t.Errorf("report does not list the promoted warnings: %v", si.Errors)
+	}
+	// The promoted insights file no longer earns its weight
+	if strict.Score >= lenient.Score || strict.Checks["security-insights-valid"].Status != checker.StatusFail {
+		t.Errorf("--strict score = %d, security-insights-valid = %s; want below %d and %s",
+			strict.Score, strict.Checks["security-insights-valid"].Status, lenient.Score, checker.StatusFail)
+	}
+}
+
+func TestValidate_Strict(t *testing.T) {
+	// Valid, but last-reviewed is long past and there are no contacts
+	repo := writeRepo(t, map[string]string{"SECURITY-INSIGHTS.yml": `header:
+  schema-version: 2.0.0
+  last-updated: '2020-01-01'
+  last-reviewed: '2020-01-01'
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`})
+	file := filepath.Join(repo, "SECURITY-INSIGHTS.yml")
+	validateOutput = filepath.Join(t.TempDir(), "report.txt")
+	t.Cleanup(func() { validateOutput, validateStrict = "", false })
+
+	if err := validateCmd.RunE(validateCmd, []string{file}); err != nil {
+		t.Fatalf("RunE() error = %v, want nil without --strict", err)
+	}
+
+	validateStrict = true
+	err := validateCmd.RunE(validateCmd, []string{file})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("RunE() error = %v, want exit code 1 under --strict", err)
+	}
+	report, _ := os.ReadFile(validateOutput)
+	if !strings.Contains(string(report), "is invalid") || !strings.Contains(string(report), "  - warning: ") {
+		t.Errorf("report does not list the warnings as errors:\n%s", report)
+	}
+}
+
+func TestCheck_RecursivePartial(t *testing.T) {
+	repo := writeCompliantRepo(t)
+	checkRecursive = true
+	checkOutput = filepath.Join(t.TempDir(), "report.txt")
+	t.Cleanup(func() { checkRecursive, checkOutput = false, "" })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	checkCmd.SetContext(ctx)
+	t.Cleanup(func() { checkCmd.SetContext(context.Background()) })
+
+	err := checkCmd.RunE(checkCmd, []string{repo})
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != exitPartial {
+		t.Errorf("RunE() error = %v, want exit code %d for a scan cut short", err, exitPartial)
+	}
+}
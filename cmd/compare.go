@@ -0,0 +1,83 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/aguamala/baseline-init/pkg/compare"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var compareOutputFormat string
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <old> <new>",
+	Short: "Show field-level differences between two SECURITY-INSIGHTS.yml files",
+	Long: `Compare two SECURITY-INSIGHTS.yml files by content rather than text.
+
+Fields are reported as added, removed or changed by their path. Entries in
+lists such as administrators or contacts are matched by name, email or value,
+so reordering them is not reported as a change. Formatting, key order and
+comments are ignored.
+
+Example:
+  baseline-init compare old/SECURITY-INSIGHTS.yml SECURITY-INSIGHTS.yml
+  git show main:SECURITY-INSIGHTS.yml > /tmp/base.yml && baseline-init compare /tmp/base.yml SECURITY-INSIGHTS.yml
+  baseline-init compare a.yml b.yml --format json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCompare,
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringVarP(&compareOutputFormat, "format", "f", "text", "Output format (text or json)")
+}
+
+func runCompare(cmd *cobra.Command, args []string) error {
+	oldData, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", args[0], err)
+	}
+	newData, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", args[1], err)
+	}
+
+	changes, err := compare.Diff(oldData, newData)
+	if err != nil {
+		return err
+	}
+
+	switch compareOutputFormat {
+	case "json":
+		if changes == nil {
+			changes = []compare.Change{}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(changes)
+	case "text":
+		if len(changes) == 0 {
+			fmt.Println("No differences")
+			return nil
+		}
+		colors := map[string]func(a ...interface{}) string{
+			compare.Added:   color.New(color.FgGreen).SprintFunc(),
+			compare.Removed: color.New(color.FgRed).SprintFunc(),
+			compare.Changed: color.New(color.FgYellow).SprintFunc(),
+		}
+		fmt.Printf("%s → %s: %d change(s)\n\n", args[0], args[1], len(changes))
+		for _, change := range changes {
+			fmt.Printf("  %s\n", colors[change.Kind](change.String()))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", compareOutputFormat)
+	}
+}
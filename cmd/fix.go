@@ -0,0 +1,82 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/fix"
+	"github.com/spf13/cobra"
+)
+
+var fixDryRun bool
+
+var fixCmd = &cobra.Command{
+	Use:   "fix [file]",
+	Short: "Resolve common SECURITY-INSIGHTS.yml validation warnings",
+	Long: `Resolve validation warnings that only need a date bumped:
+
+- header.last-updated and header.last-reviewed are set to today, and added
+  if missing
+- an expired header.expiration-date (schema 1.0.0) is moved to one year
+  from today
+
+Only those values are rewritten; formatting, comments and all other fields
+are kept. Files that fail to parse are left untouched.
+
+Example:
+  baseline-init fix
+  baseline-init fix .github/SECURITY-INSIGHTS.yml --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runFix,
+}
+
+func init() {
+	rootCmd.AddCommand(fixCmd)
+
+	fixCmd.Flags().BoolVar(&fixDryRun, "dry-run", false, "Show what would change without writing the file")
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	filePath, err := insightsFilePath(args)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("file does not exist: %s", filePath)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	fixed, changes, err := fix.Fix(data, time.Now())
+	if err != nil {
+		return fmt.Errorf("cannot fix %s: %w", filePath, err)
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("✓ %s needs no fixes\n", filePath)
+		return nil
+	}
+
+	if fixDryRun {
+		fmt.Printf("%s would change:\n", filePath)
+	} else {
+		if err := os.WriteFile(filePath, fixed, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("✓ Fixed %s:\n", filePath)
+	}
+	for _, change := range changes {
+		fmt.Printf("  - %s\n", change)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aguamala/baseline-init/pkg/generator"
+	"github.com/spf13/cobra"
+)
+
+var (
+	workflowPath   string
+	workflowBranch string
+	workflowForce  bool
+)
+
+var generateWorkflowCmd = &cobra.Command{
+	Use:   "generate-workflow <name> [path]",
+	Short: "Generate a GitHub Actions workflow, such as OpenSSF Scorecard",
+	Long: `Write a GitHub Actions workflow to .github/workflows/<name>.yml.
+
+Available workflows:
+  scorecard  Runs the OpenSSF Scorecard action weekly, on pushes to the default
+             branch and on branch protection changes, uploading the results to
+             code scanning
+
+Actions are pinned to commit SHAs and jobs get only the permissions they need.
+Dependabot's github-actions updates, as generated by setup, keep the pins
+current.
+
+Example:
+  baseline-init generate-workflow scorecard
+  baseline-init generate-workflow scorecard --branch master /path/to/repo`,
+	Args:      cobra.RangeArgs(1, 2),
+	ValidArgs: generator.Workflows(),
+	RunE:      runGenerateWorkflow,
+}
+
+func init() {
+	rootCmd.AddCommand(generateWorkflowCmd)
+
+	generateWorkflowCmd.Flags().StringVarP(&workflowPath, "path", "p", ".", "Path to repository")
+	generateWorkflowCmd.Flags().StringVar(&workflowBranch, "branch", "", "Default branch the workflow runs on (default: the branch origin/HEAD points at, else main)")
+	generateWorkflowCmd.Flags().BoolVar(&workflowForce, "force", false, "Overwrite an existing workflow file")
+}
+
+func runGenerateWorkflow(cmd *cobra.Command, args []string) error {
+	repoPath := workflowPath
+	if len(args) > 1 {
+		repoPath = args[1]
+	}
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		return fmt.Errorf("path does not exist: %s", repoPath)
+	}
+
+	return generator.New(repoPath, workflowForce).GenerateWorkflow(args[0], workflowBranch)
+}
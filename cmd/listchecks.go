@@ -0,0 +1,93 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var listChecksOutputFormat string
+
+var listChecksCmd = &cobra.Command{
+	Use:   "list-checks",
+	Short: "List the checks run by check and their metadata",
+	Long: `List every check that 'baseline-init check' runs, in the order it runs them.
+
+File checks look for one file each. They are listed with their stable ID,
+category, priority, the baseline maturity level that requires them, whether
+the file's absence makes a repository non-compliant, and the paths where the
+file is accepted.
+
+Repository checks look at the contents of the files found or at the
+repository as a whole, such as file permissions. They are listed with their
+ID, category and what they check.
+
+Both kinds can be selected with 'check --only' and '--skip'.
+
+Example:
+  baseline-init list-checks
+  baseline-init list-checks --format json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return writeChecks(cmd.OutOrStdout(), listChecksOutputFormat)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listChecksCmd)
+
+	listChecksCmd.Flags().StringVarP(&listChecksOutputFormat, "format", "f", "text", "Output format (text or json)")
+}
+
+// checkList is the JSON output of list-checks
+type checkList struct {
+	FileChecks       []checker.CheckDefinition `json:"file_checks"`
+	RepositoryChecks []checker.RepositoryCheck `json:"repository_checks"`
+}
+
+// writeChecks writes checker.Checks and checker.RepositoryChecks to w in the
+// given format
+func writeChecks(w io.Writer, format string) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(checkList{FileChecks: checker.Checks, RepositoryChecks: checker.RepositoryChecks})
+	case "text":
+		bold := color.New(color.Bold).SprintFunc()
+		fmt.Fprintln(w, bold("File checks"))
+		for _, def := range checker.Checks {
+			required := "no"
+			if def.Required {
+				required = "yes"
+			}
+			fmt.Fprintln(w)
+			fmt.Fprintf(w, "%s (%s)\n", bold(def.ID), def.File)
+			fmt.Fprintf(w, "  Category:  %s\n", def.Category)
+			fmt.Fprintf(w, "  Priority:  %s\n", def.Priority)
+			fmt.Fprintf(w, "  Level:     %d\n", def.Level)
+			fmt.Fprintf(w, "  Required:  %s\n", required)
+			fmt.Fprintf(w, "  Locations: %s\n", strings.Join(def.Locations, ", "))
+		}
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, bold("Repository checks"))
+		for _, check := range checker.RepositoryChecks {
+			fmt.Fprintln(w)
+			fmt.Fprintf(w, "%s\n", bold(check.ID))
+			fmt.Fprintf(w, "  Category:  %s\n", check.Category)
+			fmt.Fprintf(w, "  Checks:    %s\n", check.Description)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
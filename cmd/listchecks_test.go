@@ -0,0 +1,64 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestListChecks_Formats(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeChecks(&buf, "json"); err != nil {
+		t.Fatalf("writeChecks(json) error = %v", err)
+	}
+	var list checkList
+	if err := json.Unmarshal(buf.Bytes(), &list); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(list.FileChecks) < 5 {
+		t.Fatalf("listed %d file checks, want at least 5", len(list.FileChecks))
+	}
+	for _, check := range list.FileChecks {
+		if check.ID == "" || check.Category == "" || check.Priority == "" || check.Level == 0 || len(check.Locations) == 0 {
+			t.Errorf("check %+v is missing metadata", check)
+		}
+	}
+	var ids []string
+	for _, check := range list.RepositoryChecks {
+		if check.Category == "" || check.Description == "" {
+			t.Errorf("repository check %+v is missing metadata", check)
+		}
+		ids = append(ids, check.ID)
+	}
+	for _, want := range []string{"manifest", "file-modes", "tracking", "funding", "secrets"} {
+		if !slices.Contains(ids, want) {
+			t.Errorf("repository checks %v do not include %s", ids, want)
+		}
+	}
+
+	buf.Reset()
+	if err := writeChecks(&buf, "text"); err != nil {
+		t.Fatalf("writeChecks(text) error = %v", err)
+	}
+	for _, def := range checker.Checks {
+		if !strings.Contains(buf.String(), def.ID) {
+			t.Errorf("text output does not list %s", def.ID)
+		}
+	}
+	for _, check := range checker.RepositoryChecks {
+		if !strings.Contains(buf.String(), check.ID) {
+			t.Errorf("text output does not list %s", check.ID)
+		}
+	}
+
+	if err := writeChecks(&buf, "xml"); err == nil {
+		t.Error("writeChecks(xml) succeeded, want an error")
+	}
+}
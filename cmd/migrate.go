@@ -0,0 +1,81 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aguamala/baseline-init/pkg/migrate"
+	"github.com/spf13/cobra"
+)
+
+var migrateDryRun bool
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate [file]",
+	Short: "Upgrade SECURITY-INSIGHTS.yml from schema 1.0.0 to 2.0.0",
+	Long: `Rewrite a Security Insights 1.0.0 file in the 2.0.0 layout:
+
+- header.project-url becomes header.url and repository.url
+- project-lifecycle becomes repository.status and repository.bug-fixes-only
+- contribution-policy becomes repository.accepts-change-request and
+  repository.accepts-automated-change-request
+- the first email security contact becomes
+  project.vulnerability-reporting.contact and a placeholder administrator,
+  and a url contact becomes project.vulnerability-reporting.security-policy
+- dates are reformatted from RFC 3339 timestamps to YYYY-MM-DD
+
+Fields without a 2.0.0 counterpart, such as header.expiration-date, are
+dropped and listed as warnings, as are values that need a second look.
+Comments in the original file are not kept.
+
+Example:
+  baseline-init migrate
+  baseline-init migrate .github/SECURITY-INSIGHTS.yml --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMigrate,
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+
+	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Print the migrated file instead of writing it")
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	filePath, err := insightsFilePath(args)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("file does not exist: %s", filePath)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	migrated, warnings, err := migrate.Migrate(data)
+	if err != nil {
+		return fmt.Errorf("cannot migrate %s: %w", filePath, err)
+	}
+
+	if migrateDryRun {
+		fmt.Print(string(migrated))
+	} else {
+		if err := os.WriteFile(filePath, migrated, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("✓ Migrated %s to schema %s\n", filePath, migrate.TargetVersion)
+	}
+
+	// Warnings go to stderr so a dry run can be redirected to a file
+	printWarnings(os.Stderr, warnings)
+
+	return nil
+}
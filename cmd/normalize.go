@@ -0,0 +1,128 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/aguamala/baseline-init/pkg/normalize"
+	"github.com/aguamala/baseline-init/pkg/validator"
+	"github.com/spf13/cobra"
+)
+
+var (
+	normalizeDryRun bool
+	normalizeWrite  bool
+)
+
+var normalizeCmd = &cobra.Command{
+	Use:   "normalize [file]",
+	Short: "Rewrite a SECURITY-INSIGHTS.yml file in canonical form",
+	Long: `Rewrite a SECURITY-INSIGHTS.yml file with keys in specification order,
+block style, two-space indentation and quoting only where YAML needs it.
+Comments and unrecognized keys are kept.
+
+The file is validated before and after normalizing, and nothing is written if
+its content or validation results would change.
+
+Without flags the normalized file is printed to stdout.
+
+Example:
+  baseline-init normalize
+  baseline-init normalize .github/SECURITY-INSIGHTS.yml --dry-run
+  baseline-init normalize --write`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runNormalize,
+}
+
+func init() {
+	rootCmd.AddCommand(normalizeCmd)
+
+	normalizeCmd.Flags().BoolVar(&normalizeDryRun, "dry-run", false, "Report whether the file would change without writing it")
+	normalizeCmd.Flags().BoolVar(&normalizeWrite, "write", false, "Rewrite the file in place")
+
+	normalizeCmd.MarkFlagsMutuallyExclusive("dry-run", "write")
+}
+
+func runNormalize(cmd *cobra.Command, args []string) error {
+	filePath, err := insightsFilePath(args)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("file does not exist: %s", filePath)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	normalized, err := normalize.Normalize(data)
+	if err != nil {
+		return fmt.Errorf("failed to normalize %s: %w", filePath, err)
+	}
+
+	// Presentation changes must not affect what the validator reports
+	v := validator.New()
+	before, err := v.ValidateSecurityInsights(data)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	after, err := v.ValidateSecurityInsights(normalized)
+	if err != nil {
+		return fmt.Errorf("validation of normalized file failed: %w", err)
+	}
+	if !reflect.DeepEqual(before.Errors, after.Errors) {
+		return fmt.Errorf("normalizing %s would change its validation errors; leaving it untouched", filePath)
+	}
+
+	changed := !bytes.Equal(data, normalized)
+	switch {
+	case normalizeDryRun:
+		if changed {
+			fmt.Printf("%s would be normalized\n", filePath)
+		} else {
+			fmt.Printf("%s is already normalized\n", filePath)
+		}
+	case normalizeWrite:
+		if !changed {
+			fmt.Printf("✓ %s is already normalized\n", filePath)
+			return nil
+		}
+		if err := os.WriteFile(filePath, normalized, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("✓ Normalized %s\n", filePath)
+	default:
+		_, err = os.Stdout.Write(normalized)
+		return err
+	}
+
+	return nil
+}
+
+// insightsFilePath returns the file named in args, or the repository's
+// SECURITY-INSIGHTS.yml in the current directory
+func insightsFilePath(args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	for _, candidate := range []string{
+		"SECURITY-INSIGHTS.yml",
+		filepath.Join(".github", "SECURITY-INSIGHTS.yml"),
+	} {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no SECURITY-INSIGHTS.yml found; pass the file to normalize")
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestCheck_OutputFile(t *testing.T) {
+	repo := writeRepo(t, map[string]string{
+		"SECURITY-INSIGHTS.yml": `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`,
+		"SECURITY.md": "# Security Policy\n",
+		"LICENSE":     "MIT License\n",
+	})
+
+	out := filepath.Join(t.TempDir(), "reports", "nightly", "out.json")
+	rootCmd.SetArgs([]string{"check", repo, "--format", "json", "-o", out})
+	t.Cleanup(func() { checkOutputFormat, checkOutput = "text", "" })
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("report was not written: %v", err)
+	}
+	var result checker.CheckResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("report is not valid JSON: %v\n%s", err, data)
+	}
+	if result.Path != repo || !result.IsCompliant {
+		t.Errorf("report = %+v, want a compliant result for %s", result, repo)
+	}
+}
+
+func TestCheck_OutputFileKeptOnInvalidFlag(t *testing.T) {
+	repo := writeRepo(t, map[string]string{"SECURITY.md": "# Security Policy\n"})
+
+	tests := []struct {
+		name    string
+		flags   []string
+		wantErr string
+	}{
+		{name: "mistyped format", flags: []string{"--format", "jsno"}, wantErr: "invalid --format"},
+		{name: "broken template", flags: []string{"--format", "template={{.Score"}, wantErr: "invalid --format"},
+		{name: "unknown sort", flags: []string{"--format", "json", "--sort", "bogus"}, wantErr: "invalid --sort"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "out.json")
+			if err := os.WriteFile(out, []byte("previous report\n"), 0644); err != nil {
+				t.Fatalf("Failed to write %s: %v", out, err)
+			}
+
+			rootCmd.SetArgs(append([]string{"check", repo, "-o", out}, tt.flags...))
+			t.Cleanup(func() { checkOutputFormat, checkOutput, checkSort = "text", "", "priority" })
+			if err := rootCmd.Execute(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("check error = %v, want %s", err, tt.wantErr)
+			}
+
+			data, err := os.ReadFile(out)
+			if err != nil {
+				t.Fatalf("Failed to read %s: %v", out, err)
+			}
+			if string(data) != "previous report\n" {
+				t.Errorf("output file = %q, want the previous report kept", data)
+			}
+		})
+	}
+}
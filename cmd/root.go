@@ -4,9 +4,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/aguamala/baseline-init/pkg/license"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +19,38 @@ var (
 	Version   = "dev"
 	GitCommit = "unknown"
 	BuildDate = "unknown"
+
+	// quiet suppresses progress output for multi-repository operations
+	quiet bool
+
+	// timeout bounds the whole command; zero means no limit
+	timeout time.Duration
+	cancel  context.CancelFunc = func() {}
 )
 
+// exitPartial is the exit code for commands that stopped on --timeout and
+// reported partial results, matching the convention of timeout(1)
+const exitPartial = 124
+
+// ExitError is returned by commands that have already reported their outcome
+// and only need the process to exit with Code, such as check on a
+// non-compliant repository
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// exitWith returns an ExitError for code, telling cobra not to print it or
+// the usage since the command's report already explains the failure
+func exitWith(cmd *cobra.Command, code int) error {
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	return &ExitError{Code: code}
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "baseline-init",
 	Short: "OpenSSF Baseline compliance tool",
@@ -31,17 +65,38 @@ OpenSSF baseline compliance by:
 For more information about OpenSSF baseline, visit:
 https://github.com/ossf/security-baseline`,
 	Version: fmt.Sprintf("%s (commit: %s, built: %s)", Version, GitCommit, BuildDate),
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if timeout > 0 {
+			var ctx context.Context
+			ctx, cancel = context.WithTimeout(cmd.Context(), timeout)
+			cmd.SetContext(ctx)
+		}
+	},
 }
 
-// Execute runs the root command
+// Execute runs the root command and translates its error into the process
+// exit code
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	cancel()
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.Code)
+	}
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
 
 func init() {
+	// Report the bundled data release so users know how current it is
 	rootCmd.SetVersionTemplate(`{{.Version}}
+SPDX license list: ` + license.ListVersion() + `
 `)
+
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress progress output")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0,
+		fmt.Sprintf("Stop starting new checks after this long and report partial results (exit code %d)", exitPartial))
 }
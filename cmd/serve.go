@@ -0,0 +1,91 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/server"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serveAddr string
+	serveRoot string
+)
+
+// shutdownTimeout is how long in-flight requests may take to finish once
+// the server is asked to stop
+const shutdownTimeout = 10 * time.Second
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve compliance checks and validation over HTTP",
+	Long: `Start an HTTP server that answers compliance queries with the same JSON
+that check and validate produce with --format json:
+
+  GET  /healthz                 Liveness probe, returns {"status": "ok"}
+  GET  /check?path=<repo>       CheckResult for a repository under --root
+  POST /validate                ValidationResult for the SECURITY-INSIGHTS.yml in the body
+
+Repository paths are relative to --root and cannot leave it. The server has
+no authentication and listens on localhost by default; put it behind your own
+access control before exposing it.
+
+Example:
+  baseline-init serve --root /srv/repos
+  curl 'localhost:8080/check?path=service-a'
+  curl --data-binary @SECURITY-INSIGHTS.yml localhost:8080/validate`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:8080", "Address to listen on")
+	serveCmd.Flags().StringVar(&serveRoot, "root", ".", "Directory containing the repositories that may be checked")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	handler, err := server.New(serveRoot)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              serveAddr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+	fmt.Fprintf(os.Stderr, "Listening on %s (repositories under %s)\n", serveAddr, serveRoot)
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	return nil
+}
@@ -4,12 +4,25 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/aguamala/baseline-init/pkg/checker"
 	"github.com/aguamala/baseline-init/pkg/generator"
 	"github.com/aguamala/baseline-init/pkg/interactive"
+	"github.com/aguamala/baseline-init/pkg/manifest"
+	"github.com/aguamala/baseline-init/pkg/progress"
+	"github.com/aguamala/baseline-init/pkg/report"
+	"github.com/aguamala/baseline-init/pkg/validator"
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -17,8 +30,37 @@ var (
 	setupInteractive bool
 	setupPath        string
 	setupForce       bool
+	setupManifest    bool
+	setupBatchFile   string
+	setupPrintConfig string
+	setupOverrides   []string
+	setupOwner       string
+	setupRepoName    string
+	setupLicenseURL  string
+	setupSkipCoC     bool
+	setupSkipContrib bool
+	setupSkipOwners  bool
+	setupSkipUpdates bool
+	setupDryRun      bool
+
+	// Config values set directly, for automation that can't answer prompts
+	setupProjectURL     string
+	setupProjectName    string
+	setupSecurityEmail  string
+	setupStage          string
+	setupMaintainers    []string
+	setupAcceptsPRs     bool
+	setupAcceptsAutoPRs bool
+	setupBugFixesOnly   bool
 )
 
+// setupConfigFlags set config values directly; any of them implies --auto
+// when no mode is given
+var setupConfigFlags = []string{
+	"project-url", "project-name", "security-email", "stage", "maintainers",
+	"accepts-prs", "accepts-automated-prs", "bug-fixes-only",
+}
+
 var setupCmd = &cobra.Command{
 	Use:   "setup [path]",
 	Short: "Setup OpenSSF baseline compliance files",
@@ -33,7 +75,18 @@ Example:
   baseline-init setup --auto
   baseline-init setup --interactive
   baseline-init setup --auto /path/to/repo
-  baseline-init setup --auto --force  # Overwrite existing files`,
+  baseline-init setup --auto --force  # Overwrite existing files
+  baseline-init setup --auto --force --dry-run  # Preview the files without writing them
+  baseline-init setup --auto --manifest  # Record generated files in .baseline-manifest.json
+  baseline-init setup --from-manifest repos.yaml  # Set up many repositories at once
+  baseline-init setup --auto --print-config       # Show the resolved config without generating
+  baseline-init setup --auto --set SecurityEmail=sec@acme.com --set ProjectStage=wip
+  baseline-init setup --auto --owner acme --repo-name widget  # No git remote needed
+  baseline-init setup --auto --license-url-style relative     # license.url: LICENSE
+  baseline-init setup --auto --skip-coc --skip-contributing   # Only the security files
+  baseline-init setup --project-url https://gitlab.com/acme/widget \
+    --security-email security@acme.com --stage wip --maintainers alice,gitlab:bob \
+    --accepts-automated-prs=false  # Non-interactive, for pipelines`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runSetup,
 }
@@ -45,11 +98,53 @@ func init() {
 	setupCmd.Flags().BoolVar(&setupInteractive, "interactive", false, "Interactive setup mode")
 	setupCmd.Flags().StringVarP(&setupPath, "path", "p", ".", "Path to repository")
 	setupCmd.Flags().BoolVar(&setupForce, "force", false, "Overwrite existing files")
+	setupCmd.Flags().BoolVar(&setupManifest, "manifest", false, "Write a manifest of generated files and their hashes")
+	setupCmd.Flags().BoolVar(&setupDryRun, "dry-run", false, "Print the files that would be generated, and their contents, without writing them")
+
+	setupCmd.Flags().StringVar(&setupBatchFile, "from-manifest", "", "Set up every repository listed in a batch YAML file")
+	setupCmd.Flags().StringVar(&setupPrintConfig, "print-config", "", "Print the resolved configuration (yaml or json) instead of generating files")
+	setupCmd.Flags().Lookup("print-config").NoOptDefVal = "yaml"
+	setupCmd.Flags().StringArrayVar(&setupOverrides, "set", nil, "Override a config field as key=value (repeatable)")
+	setupCmd.Flags().StringVar(&setupLicenseURL, "license-url-style", "", "How to write repository.license.url: absolute (full URL, the default) or relative (LICENSE)")
 
+	setupCmd.Flags().BoolVar(&setupSkipCoC, "skip-coc", false, "Don't generate CODE_OF_CONDUCT.md")
+	setupCmd.Flags().BoolVar(&setupSkipContrib, "skip-contributing", false, "Don't generate CONTRIBUTING.md")
+	setupCmd.Flags().BoolVar(&setupSkipOwners, "skip-codeowners", false, "Don't generate .github/CODEOWNERS")
+	setupCmd.Flags().BoolVar(&setupSkipUpdates, "skip-dependabot", false, "Don't generate .github/dependabot.yml")
+
+	setupCmd.Flags().StringVar(&setupOwner, "owner", "", "Repository owner, used with --repo-name to build the project URL without git")
+	setupCmd.Flags().StringVar(&setupRepoName, "repo-name", "", "Repository name, used with --owner to build the project URL without git")
+
+	setupCmd.Flags().StringVar(&setupProjectURL, "project-url", "", "Repository URL to declare, instead of the default")
+	setupCmd.Flags().StringVar(&setupProjectName, "project-name", "", "Project name (default: the directory name)")
+	setupCmd.Flags().StringVar(&setupSecurityEmail, "security-email", "", "Address for vulnerability reports")
+	setupCmd.Flags().StringVar(&setupStage, "stage", "", "Lifecycle stage: active, archived, concept, moved, or wip")
+	setupCmd.Flags().StringSliceVar(&setupMaintainers, "maintainers", nil, "Maintainers, comma-separated: username, gitlab:user or mastodon:@user@host")
+	setupCmd.Flags().BoolVar(&setupAcceptsPRs, "accepts-prs", true, "Declare that the repository accepts pull requests")
+	setupCmd.Flags().BoolVar(&setupAcceptsAutoPRs, "accepts-automated-prs", true, "Declare that the repository accepts automated pull requests")
+	setupCmd.Flags().BoolVar(&setupBugFixesOnly, "bug-fixes-only", false, "Declare that only bug fixes are accepted")
+
+	setupCmd.MarkFlagsRequiredTogether("owner", "repo-name")
+	setupCmd.MarkFlagsMutuallyExclusive("project-url", "owner")
+	setupCmd.MarkFlagsMutuallyExclusive("from-manifest", "project-url")
+	setupCmd.MarkFlagsMutuallyExclusive("from-manifest", "project-name")
+	for _, name := range setupConfigFlags {
+		setupCmd.MarkFlagsMutuallyExclusive("interactive", name)
+	}
+	setupCmd.MarkFlagsMutuallyExclusive("from-manifest", "owner")
 	setupCmd.MarkFlagsMutuallyExclusive("auto", "interactive")
+	setupCmd.MarkFlagsMutuallyExclusive("dry-run", "print-config")
+	setupCmd.MarkFlagsMutuallyExclusive("from-manifest", "interactive")
 }
 
 func runSetup(cmd *cobra.Command, args []string) error {
+	if setupPrintConfig != "" && setupPrintConfig != "yaml" && setupPrintConfig != "json" {
+		return fmt.Errorf("invalid --print-config %q: must be yaml or json", setupPrintConfig)
+	}
+	if setupBatchFile != "" {
+		return runSetupBatch(cmd, setupBatchFile)
+	}
+
 	// Determine repository path
 	repoPath := setupPath
 	if len(args) > 0 {
@@ -61,28 +156,71 @@ func runSetup(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("path does not exist: %s", repoPath)
 	}
 
-	// If neither mode specified, default to interactive
+	// If neither mode specified, default to interactive unless config
+	// values were given as flags
 	if !setupAuto && !setupInteractive {
-		setupInteractive = true
+		setupAuto = anyFlagChanged(cmd, setupConfigFlags)
+		setupInteractive = !setupAuto
 	}
 
-	gen := generator.New(repoPath, setupForce)
+	gen := newGenerator(repoPath)
+	gen.SetNoPrompt(setupAuto)
 
+	// An explicit owner and name take precedence over the git remote
+	projectURL := ""
+	if setupOwner != "" {
+		var err error
+		projectURL, err = generator.RepositoryURL(setupOwner, setupRepoName)
+		if err != nil {
+			return err
+		}
+	}
+
+	var config *generator.Config
 	if setupInteractive {
 		// Interactive mode: gather user input
-		config, err := interactive.GatherConfiguration(repoPath)
+		var err error
+		config, err = interactive.GatherConfiguration(repoPath, projectURL)
 		if err != nil {
 			return fmt.Errorf("failed to gather configuration: %w", err)
 		}
-
-		if err := gen.GenerateWithConfig(config); err != nil {
-			return fmt.Errorf("failed to generate files: %w", err)
-		}
 	} else {
 		// Auto mode: generate with defaults
-		if err := gen.GenerateDefaults(); err != nil {
-			return fmt.Errorf("failed to generate files: %w", err)
+		config = gen.DefaultConfig()
+		if projectURL != "" {
+			config.ProjectURL = projectURL
+		}
+	}
+
+	if setupLicenseURL != "" {
+		config.LicenseURLStyle = setupLicenseURL
+	}
+	if err := applyConfigFlags(cmd, config); err != nil {
+		return err
+	}
+	if err := generator.ApplyOverrides(config, setupOverrides); err != nil {
+		return err
+	}
+	if _, err := generator.LicenseURL(config); err != nil {
+		return err
+	}
+
+	if setupPrintConfig != "" {
+		return printConfig(os.Stdout, config, setupPrintConfig)
+	}
+	if setupDryRun {
+		return gen.DryRun(config, os.Stdout)
+	}
+
+	if err := gen.GenerateWithConfig(config); err != nil {
+		return fmt.Errorf("failed to generate files: %w", err)
+	}
+
+	if setupManifest {
+		if err := writeManifest(repoPath, config, gen.GeneratedFiles()); err != nil {
+			return fmt.Errorf("failed to write manifest: %w", err)
 		}
+		fmt.Printf("✓ Recorded generated files in %s\n", manifest.FileName)
 	}
 
 	fmt.Println("\n✓ OpenSSF baseline compliance files generated successfully!")
@@ -91,5 +229,248 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	fmt.Println("  2. Run 'baseline-init check' to validate")
 	fmt.Println("  3. Commit the files to your repository")
 
+	// Close the loop for new users by offering to verify right away; --auto
+	// never prompts
+	if !setupAuto && isatty.IsTerminal(os.Stdin.Fd()) {
+		fmt.Println()
+		if interactive.ConfirmCheck() {
+			return runSetupCheck(repoPath)
+		}
+	}
+
 	return nil
 }
+
+// anyFlagChanged reports whether any of the named flags was set
+func anyFlagChanged(cmd *cobra.Command, names []string) bool {
+	for _, name := range names {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyConfigFlags copies the config values given as flags into config,
+// leaving the detected or default value of every flag that was not set
+func applyConfigFlags(cmd *cobra.Command, config *generator.Config) error {
+	flags := cmd.Flags()
+
+	if flags.Changed("project-url") {
+		config.ProjectURL = setupProjectURL
+	}
+	if flags.Changed("project-name") {
+		config.ProjectName = setupProjectName
+	}
+	if flags.Changed("security-email") {
+		if err := validator.ValidateEmail(setupSecurityEmail); err != nil {
+			return fmt.Errorf("invalid --security-email: %w", err)
+		}
+		config.SecurityEmail = setupSecurityEmail
+	}
+	if flags.Changed("stage") {
+		if !slices.Contains(generator.ProjectStages, setupStage) {
+			return fmt.Errorf("invalid --stage %q: must be one of %s", setupStage, strings.Join(generator.ProjectStages, ", "))
+		}
+		config.ProjectStage = setupStage
+	}
+	if flags.Changed("maintainers") {
+		config.Maintainers = []string{}
+		for _, m := range setupMaintainers {
+			if m = strings.TrimSpace(m); m != "" {
+				config.Maintainers = append(config.Maintainers, generator.ParseMaintainer(m).String())
+			}
+		}
+	}
+	if flags.Changed("accepts-prs") {
+		config.AcceptsPullRequests = setupAcceptsPRs
+	}
+	if flags.Changed("accepts-automated-prs") {
+		config.AcceptsAutomatedPR = setupAcceptsAutoPRs
+	}
+	if flags.Changed("bug-fixes-only") {
+		config.BugFixesOnly = setupBugFixesOnly
+	}
+	return nil
+}
+
+// newGenerator creates a generator honoring --force and the --skip-* flags
+func newGenerator(repoPath string) *generator.Generator {
+	gen := generator.New(repoPath, setupForce)
+	if setupSkipCoC {
+		gen.Skip("CODE_OF_CONDUCT.md")
+	}
+	if setupSkipContrib {
+		gen.Skip("CONTRIBUTING.md")
+	}
+	if setupSkipOwners {
+		gen.Skip(".github/CODEOWNERS")
+	}
+	if setupSkipUpdates {
+		gen.Skip(".github/dependabot.yml")
+	}
+	return gen
+}
+
+// runSetupCheck checks the freshly set up repository and prints the report.
+// Unlike the check command it does not exit non-zero when not compliant.
+func runSetupCheck(repoPath string) error {
+	result, err := checker.New(repoPath).Check()
+	if err != nil {
+		return fmt.Errorf("compliance check failed: %w", err)
+	}
+
+	fmt.Println()
+	if err := report.NewReporter("text").OutputCheckResult(result); err != nil {
+		return fmt.Errorf("failed to output results: %w", err)
+	}
+	return nil
+}
+
+// writeManifest records the generated files in the repository manifest,
+// keeping entries for files generated by earlier runs
+func writeManifest(repoPath string, config *generator.Config, files []string) error {
+	m, err := manifest.Load(repoPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		m = manifest.New(config)
+	}
+	m.GeneratedAt = time.Now().UTC().Format(time.RFC3339)
+	m.Config = config
+
+	for _, f := range files {
+		if err := m.AddFile(repoPath, f); err != nil {
+			return err
+		}
+	}
+
+	return m.Write(repoPath)
+}
+
+// runSetupBatch generates files for each repository in a batch file,
+// continuing past failures and reporting the outcome per repository
+func runSetupBatch(cmd *cobra.Command, batchPath string) error {
+	batch, err := generator.LoadBatch(batchPath)
+	if err != nil {
+		return err
+	}
+
+	// With --print-config stdout holds only the configs, so they can be
+	// parsed, and the lines about each repository go to stderr
+	status := io.Writer(os.Stdout)
+	if setupPrintConfig != "" {
+		status = os.Stderr
+	}
+
+	bar := progress.New(len(batch.Repos), quiet)
+	defer bar.Finish()
+
+	var generated, skipped, failed int
+	for _, entry := range batch.Repos {
+		// Keep the bar off the lines this entry prints, then redraw it
+		bar.Clear()
+		fmt.Fprintf(status, "\n==> %s\n", entry.Path)
+
+		outcome, err := setupBatchEntry(cmd, entry, status)
+		switch outcome {
+		case "generated":
+			generated++
+		case "skipped":
+			skipped++
+		case "failed":
+			failed++
+		}
+		if err != nil {
+			return err
+		}
+
+		bar.Increment(filepath.Base(entry.Path))
+	}
+	bar.Finish()
+
+	if setupPrintConfig != "" || setupDryRun {
+		return nil
+	}
+
+	fmt.Printf("\nBatch setup complete: %d generated, %d skipped, %d failed\n", generated, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d repositories failed", failed, len(batch.Repos))
+	}
+	return nil
+}
+
+// setupBatchEntry generates files for one batch entry and returns its
+// outcome: generated, skipped or failed. Per-repository problems are printed
+// to status and reported as failed; only errors that should stop the batch
+// are returned.
+func setupBatchEntry(cmd *cobra.Command, entry generator.BatchEntry, status io.Writer) (string, error) {
+	if _, err := os.Stat(entry.Path); os.IsNotExist(err) {
+		fmt.Fprintf(status, "→ Skipped: path does not exist\n")
+		return "skipped", nil
+	}
+
+	gen := newGenerator(entry.Path)
+	config := gen.DefaultConfig()
+	if err := entry.ApplyTo(config); err != nil {
+		fmt.Fprintf(status, "✗ Failed: %v\n", err)
+		return "failed", nil
+	}
+	if setupLicenseURL != "" {
+		config.LicenseURLStyle = setupLicenseURL
+	}
+	if err := applyConfigFlags(cmd, config); err != nil {
+		return "failed", err
+	}
+	if err := generator.ApplyOverrides(config, setupOverrides); err != nil {
+		return "failed", err
+	}
+	if _, err := generator.LicenseURL(config); err != nil {
+		return "failed", err
+	}
+
+	if setupPrintConfig != "" {
+		// Each repository's config is a YAML document of its own
+		if setupPrintConfig == "yaml" {
+			fmt.Println("---")
+		}
+		return "", printConfig(os.Stdout, config, setupPrintConfig)
+	}
+	if setupDryRun {
+		return "", gen.DryRun(config, os.Stdout)
+	}
+
+	if err := gen.GenerateWithConfig(config); err != nil {
+		fmt.Printf("✗ Failed: %v\n", err)
+		return "failed", nil
+	}
+
+	if setupManifest {
+		if err := writeManifest(entry.Path, config, gen.GeneratedFiles()); err != nil {
+			fmt.Printf("✗ Failed to write manifest: %v\n", err)
+			return "failed", nil
+		}
+	}
+
+	if len(gen.GeneratedFiles()) == 0 {
+		return "skipped", nil
+	}
+	return "generated", nil
+}
+
+// printConfig writes the resolved generation config to w
+func printConfig(w io.Writer, config *generator.Config, format string) error {
+	switch format {
+	case "yaml":
+		encoder := yaml.NewEncoder(w)
+		defer encoder.Close()
+		return encoder.Encode(config)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(config)
+	default:
+		return fmt.Errorf("unsupported config format: %s", format)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/generator"
+	sitooling "github.com/ossf/si-tooling/v2/si"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// setFlags sets flags on cmd as if given on the command line, restoring
+// their defaults when the test ends
+func setFlags(t *testing.T, flags *pflag.FlagSet, values map[string]string) {
+	t.Helper()
+	t.Cleanup(func() {
+		flags.VisitAll(func(f *pflag.Flag) {
+			if !f.Changed {
+				return
+			}
+			if slice, ok := f.Value.(pflag.SliceValue); ok {
+				slice.Replace(nil)
+			} else {
+				f.Value.Set(f.DefValue)
+			}
+			f.Changed = false
+		})
+	})
+
+	for name, value := range values {
+		if err := flags.Set(name, value); err != nil {
+			t.Fatalf("Set(%s) error = %v", name, err)
+		}
+	}
+}
+
+func TestSetup_ConfigFlags(t *testing.T) {
+	repo := t.TempDir()
+	setFlags(t, setupCmd.Flags(), map[string]string{
+		"project-url":           "https://gitlab.com/acme/widget",
+		"project-name":          "Widget",
+		"security-email":        "security@acme.com",
+		"stage":                 "wip",
+		"maintainers":           "alice,gitlab:bob",
+		"accepts-automated-prs": "false",
+		"bug-fixes-only":        "true",
+	})
+	t.Cleanup(func() { setupAuto, setupInteractive = false, false })
+
+	// No mode is given: the config flags imply --auto rather than prompting
+	if err := runSetup(setupCmd, []string{repo}); err != nil {
+		t.Fatalf("runSetup() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(repo, "SECURITY-INSIGHTS.yml"))
+	if err != nil {
+		t.Fatalf("SECURITY-INSIGHTS.yml not generated: %v", err)
+	}
+	var insights sitooling.SecurityInsights
+	if err := yaml.Unmarshal(data, &insights); err != nil {
+		t.Fatalf("generated file does not parse: %v", err)
+	}
+
+	if insights.Header.URL != "https://gitlab.com/acme/widget" || insights.Repository.URL != "https://gitlab.com/acme/widget" {
+		t.Errorf("header.url = %s, repository.url = %s", insights.Header.URL, insights.Repository.URL)
+	}
+	if insights.Project.Name != "Widget" {
+		t.Errorf("project.name = %s, want Widget", insights.Project.Name)
+	}
+	declared := insights.Repository
+	if declared.Status != "wip" || !declared.AcceptsChangeRequest || declared.AcceptsAutomatedChangeRequest {
+		t.Errorf("repository = status %s, accepts-change-request %v, accepts-automated-change-request %v",
+			declared.Status, declared.AcceptsChangeRequest, declared.AcceptsAutomatedChangeRequest)
+	}
+	var socials []string
+	for _, admin := range insights.Project.Administrators {
+		socials = append(socials, admin.Social)
+	}
+	if got := strings.Join(socials, ","); got != "https://github.com/alice,https://gitlab.com/bob" {
+		t.Errorf("administrator socials = %s", got)
+	}
+
+	// The security email and bug-fixes-only policy show up in the policy files
+	for name, want := range map[string]string{
+		"SECURITY.md":     "security@acme.com",
+		"CONTRIBUTING.md": "only accepting bug fixes",
+	} {
+		content, err := os.ReadFile(filepath.Join(repo, name))
+		if err != nil {
+			t.Fatalf("%s not generated: %v", name, err)
+		}
+		if !strings.Contains(string(content), want) {
+			t.Errorf("%s does not mention %q", name, want)
+		}
+	}
+}
+
+func TestSetup_ConfigFlagsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		wantErr string
+	}{
+		{name: "malformed email", flags: map[string]string{"security-email": "security@"}, wantErr: "invalid --security-email"},
+		{name: "unknown stage", flags: map[string]string{"stage": "retired"}, wantErr: "invalid --stage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := t.TempDir()
+			setFlags(t, setupCmd.Flags(), tt.flags)
+			t.Cleanup(func() { setupAuto, setupInteractive = false, false })
+
+			err := runSetup(setupCmd, []string{repo})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runSetup() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+			if _, err := os.Stat(filepath.Join(repo, "SECURITY-INSIGHTS.yml")); err == nil {
+				t.Errorf("SECURITY-INSIGHTS.yml was generated despite the invalid flag")
+			}
+		})
+	}
+}
+
+func TestSetup_AutoKeepsExistingFiles(t *testing.T) {
+	repo := t.TempDir()
+	path := filepath.Join(repo, "SECURITY.md")
+	if err := os.WriteFile(path, []byte("custom policy\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	setupAuto = true
+	t.Cleanup(func() { setupAuto, setupInteractive = false, false })
+
+	// An existing file would otherwise ask whether to overwrite it
+	if err := runSetup(setupCmd, []string{repo}); err != nil {
+		t.Fatalf("runSetup() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "custom policy\n" {
+		t.Errorf("SECURITY.md = %q, want it kept", data)
+	}
+	if _, err := os.Stat(filepath.Join(repo, "SECURITY-INSIGHTS.yml")); err != nil {
+		t.Errorf("SECURITY-INSIGHTS.yml not generated: %v", err)
+	}
+}
+
+// captureStdout returns what fn writes to os.Stdout
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+	fnErr := fn()
+	w.Close()
+	return <-output, fnErr
+}
+
+func TestSetup_PrintConfigBatch(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"api", "web"} {
+		if err := os.Mkdir(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	batch := filepath.Join(dir, "repos.yml")
+	content := `repos:
+  - path: api
+    config:
+      project-name: API
+  - path: gone
+  - path: web
+    config:
+      project-name: Web
+`
+	if err := os.WriteFile(batch, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		format string
+		decode func(output string) ([]string, error)
+	}{
+		{
+			format: "yaml",
+			decode: func(output string) ([]string, error) {
+				var names []string
+				decoder := yaml.NewDecoder(strings.NewReader(output))
+				for {
+					var config generator.Config
+					if err := decoder.Decode(&config); errors.Is(err, io.EOF) {
+						return names, nil
+					} else if err != nil {
+						return nil, err
+					}
+					names = append(names, config.ProjectName)
+				}
+			},
+		},
+		{
+			format: "json",
+			decode: func(output string) ([]string, error) {
+				var names []string
+				decoder := json.NewDecoder(strings.NewReader(output))
+				for decoder.More() {
+					var config generator.Config
+					if err := decoder.Decode(&config); err != nil {
+						return nil, err
+					}
+					names = append(names, config.ProjectName)
+				}
+				return names, nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			setupBatchFile, setupPrintConfig = batch, tt.format
+			t.Cleanup(func() { setupBatchFile, setupPrintConfig = "", "" })
+
+			output, err := captureStdout(t, func() error { return runSetup(setupCmd, nil) })
+			if err != nil {
+				t.Fatalf("runSetup() error = %v", err)
+			}
+
+			// Only the configs reach stdout, so the whole stream parses
+			names, err := tt.decode(output)
+			if err != nil {
+				t.Fatalf("output does not parse as a %s stream: %v\n%s", tt.format, err, output)
+			}
+			if want := []string{"API", "Web"}; !reflect.DeepEqual(names, want) {
+				t.Errorf("project names = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestSetup_PrintConfigInvalid(t *testing.T) {
+	setupPrintConfig = "toml"
+	t.Cleanup(func() { setupPrintConfig = "" })
+
+	err := runSetup(setupCmd, []string{t.TempDir()})
+	if err == nil || !strings.Contains(err.Error(), "invalid --print-config") {
+		t.Errorf("runSetup() error = %v, want invalid --print-config", err)
+	}
+}
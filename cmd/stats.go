@@ -0,0 +1,100 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/report"
+	"github.com/spf13/cobra"
+)
+
+var statsOutputFormat string
+
+var statsCmd = &cobra.Command{
+	Use:   "stats <dir>",
+	Short: "Summarize compliance across saved JSON reports",
+	Long: `Read every .json file in a directory of saved check or audit reports and
+print aggregate statistics: the share of compliant reports, the average score,
+the most commonly missing files and the score distribution.
+
+Files may hold a single-repository report or a multi-repository report, as
+written by --format json. Use it on a history of reports for one repository
+to see a trend, or on reports from many repositories for a portfolio view.
+
+Example:
+  baseline-init audit --format json > reports/$(date +%F).json
+  baseline-init stats reports/
+  baseline-init stats reports/ --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStats,
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().StringVarP(&statsOutputFormat, "format", "f", "text", "Output format (text or json)")
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	results, err := loadReports(args[0])
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no JSON reports found in %s", args[0])
+	}
+
+	return report.NewReporter(statsOutputFormat).OutputStats(report.Summarize(results))
+}
+
+// loadReports reads the check results saved as .json files in dir, in file
+// name order. Multi-repository reports contribute each of their
+// repositories.
+func loadReports(dir string) ([]*checker.CheckResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var results []*checker.CheckResult
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), ".json") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		var doc struct {
+			checker.CheckResult
+			Groups []report.GroupEntry `json:"groups"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		switch {
+		case len(doc.Groups) > 0:
+			for _, group := range doc.Groups {
+				results = append(results, group.Repositories...)
+			}
+		case doc.Path != "" || len(doc.Files) > 0:
+			result := doc.CheckResult
+			results = append(results, &result)
+		default:
+			return nil, fmt.Errorf("%s is not a check report", path)
+		}
+	}
+	return results, nil
+}
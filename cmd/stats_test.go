@@ -0,0 +1,97 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStats_LoadReports(t *testing.T) {
+	current := `{"report_version": "1", "path": "current", "is_compliant": true, "files": [], "missing_files": [], "score": 100, "max_score": 8, "earned_score": 8}`
+	legacy := `{"path": "legacy", "is_compliant": false, "files": [{"name": "LICENSE", "exists": false}], "missing_files": ["LICENSE"]}`
+	multi := `{"report_version": "1", "total": 2, "compliant": 1, "groups": [
+  {"name": "compliant", "repositories": [{"path": "a", "is_compliant": true}]},
+  {"name": "not compliant", "repositories": [{"path": "b", "is_compliant": false}]}
+]}`
+
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantPaths []string
+		wantErr   string
+	}{
+		{
+			name:      "empty directory",
+			files:     map[string]string{},
+			wantPaths: nil,
+		},
+		{
+			name:      "mixed report versions in file name order",
+			files:     map[string]string{"2.json": current, "1.json": legacy},
+			wantPaths: []string{"legacy", "current"},
+		},
+		{
+			name:      "multi-repository report",
+			files:     map[string]string{"audit.json": multi, "single.JSON": current},
+			wantPaths: []string{"a", "b", "current"},
+		},
+		{
+			name:      "other files are ignored",
+			files:     map[string]string{"report.json": current, "notes.txt": "not json", "report.yaml": "path: x\n"},
+			wantPaths: []string{"current"},
+		},
+		{
+			name:    "malformed file",
+			files:   map[string]string{"1.json": current, "2.json": `{"path": "broken"`},
+			wantErr: "failed to parse",
+		},
+		{
+			name:    "not a check report",
+			files:   map[string]string{"package.json": `{"name": "example"}`},
+			wantErr: "is not a check report",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", name, err)
+				}
+			}
+
+			results, err := loadReports(dir)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadReports() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadReports() error = %v", err)
+			}
+
+			var paths []string
+			for _, result := range results {
+				paths = append(paths, result.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("loadReports() paths = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestStats_NoReports(t *testing.T) {
+	dir := t.TempDir()
+	err := statsCmd.RunE(statsCmd, []string{dir})
+	if err == nil || !strings.Contains(err.Error(), "no JSON reports found") {
+		t.Errorf("stats on an empty directory error = %v, want no JSON reports found", err)
+	}
+}
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/aguamala/baseline-init/pkg/validator"
@@ -15,17 +16,42 @@ var validateCmd = &cobra.Command{
 	Use:   "validate <file>",
 	Short: "Validate a compliance file against its schema",
 	Long: `Validate OpenSSF compliance files (like SECURITY-INSIGHTS.yml)
-against their official schemas.
+against their official schemas. GOVERNANCE.md is checked for its key sections.
+With --check-urls, the reporting addresses in SECURITY.md must accept mail.
 
 Example:
   baseline-init validate SECURITY-INSIGHTS.yml
-  baseline-init validate .github/SECURITY-INSIGHTS.yml`,
+  baseline-init validate .github/SECURITY-INSIGHTS.yml
+  baseline-init validate GOVERNANCE.md
+  baseline-init validate SECURITY.md --check-urls
+  baseline-init validate SECURITY-INSIGHTS.yml --strict
+  baseline-init validate SECURITY-INSIGHTS.yml -o reports/validation.txt`,
 	Args: cobra.ExactArgs(1),
 	RunE: runValidate,
 }
 
+var (
+	validateSecurityURLKeywords []string
+	validateCheckURLs           bool
+	validateStripPlusTags       bool
+	validateNoUpgradeNote       bool
+	validateReviewMaxAge        int
+	validateStrict              bool
+	validateOutput              string
+)
+
 func init() {
 	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringSliceVar(&validateSecurityURLKeywords, "security-url-keywords",
+		validator.DefaultSecurityURLKeywords, "Path keywords expected in url-type security contacts")
+	validateCmd.Flags().BoolVar(&validateCheckURLs, "check-urls", false, "Enable network checks such as MX lookups for contact email domains and bug bounty page reachability")
+	validateCmd.Flags().BoolVar(&validateNoUpgradeNote, "no-upgrade-note", false, "Don't suggest upgrading files that declare an older schema-version")
+	validateCmd.Flags().IntVar(&validateReviewMaxAge, "review-max-age", validator.DefaultReviewMaxAgeMonths,
+		"Warn when header.last-reviewed is more than this many months ago (0 disables)")
+	validateCmd.Flags().StringVarP(&validateOutput, "output", "o", "", "Write the report to this file instead of stdout, creating parent directories as needed")
+	validateCmd.Flags().BoolVar(&validateStrict, "strict", false, "Treat warnings as errors, failing the validation if there are any")
+	validateCmd.Flags().BoolVar(&validateStripPlusTags, "ignore-plus-tags", false, "Treat user+tag@host and user@host as the same contact when looking for duplicate emails")
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
@@ -38,28 +64,91 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 	// Validate the file
 	v := validator.New()
+	v.SetSecurityURLKeywords(validateSecurityURLKeywords)
+	v.SetNetworkChecks(validateCheckURLs)
+	v.SetStripPlusTags(validateStripPlusTags)
+	v.SetUpgradeNotes(!validateNoUpgradeNote)
+	v.SetReviewMaxAge(validateReviewMaxAge)
 	result, err := v.ValidateFile(filePath)
 	if err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	if validateStrict {
+		result.PromoteWarnings()
+	}
 
+	out, err := openOutput(validateOutput)
+	if err != nil {
+		return err
+	}
+	printValidation(out, filePath, result)
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if !result.IsValid {
+		return exitWith(cmd, 1)
+	}
+	return nil
+}
+
+// printValidation writes the verdict followed by coverage, warnings and,
+// for valid files, notes
+func printValidation(w io.Writer, filePath string, result *validator.ValidationResult) {
 	if result.IsValid {
-		fmt.Printf("✓ %s is valid\n", filePath)
-		return nil
+		fmt.Fprintf(w, "✓ %s is valid\n", filePath)
+		if result.SchemaInferred {
+			fmt.Fprintf(w, "  (no recognized schema-version; validated as schema %s)\n", result.SchemaVersion)
+		}
+		printCoverage(w, result)
+		printWarnings(w, result.Warnings)
+		printNotes(w, result.Notes)
+		return
 	}
 
-	fmt.Printf("✗ %s is invalid:\n", filePath)
+	fmt.Fprintf(w, "✗ %s is invalid:\n", filePath)
 	for _, e := range result.Errors {
-		fmt.Printf("  - %s\n", e)
+		fmt.Fprintf(w, "  - %s\n", e)
 	}
 
-	if len(result.Warnings) > 0 {
-		fmt.Println("\nWarnings:")
-		for _, w := range result.Warnings {
-			fmt.Printf("  - %s\n", w)
-		}
+	printCoverage(w, result)
+	printWarnings(w, result.Warnings)
+}
+
+// printCoverage reports the document size and which recommended sections
+// are still empty, as a roadmap towards a complete insights file
+func printCoverage(w io.Writer, result *validator.ValidationResult) {
+	if result.SizeBytes > 0 {
+		fmt.Fprintf(w, "\nSize: %d bytes\n", result.SizeBytes)
+	}
+	if result.Coverage == nil {
+		return
 	}
+	fmt.Fprintf(w, "Coverage: %d%% of recommended sections present (%d of %d)\n",
+		result.Coverage.Percent(), result.Coverage.Present, result.Coverage.Total)
+	for _, missing := range result.Coverage.Missing {
+		fmt.Fprintf(w, "  - not yet documented: %s\n", missing)
+	}
+}
 
-	os.Exit(1)
-	return nil
+// printNotes lists advisory notes last, as they need no action to pass
+func printNotes(w io.Writer, notes []string) {
+	if len(notes) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nNotes:")
+	for _, n := range notes {
+		fmt.Fprintf(w, "  - %s\n", n)
+	}
+}
+
+// printWarnings lists validation warnings after the verdict
+func printWarnings(w io.Writer, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nWarnings:")
+	for _, warning := range warnings {
+		fmt.Fprintf(w, "  - %s\n", warning)
+	}
 }
@@ -0,0 +1,59 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/validator"
+)
+
+func TestValidate_PrintWarnings(t *testing.T) {
+	mx := "security-contacts[0] email domain example.invalid has no MX records; reports may not be deliverable"
+	bounty := "bug-bounty-url is advertised but responded with HTTP 404: https://example.com/bounty"
+
+	tests := []struct {
+		name    string
+		result  validator.ValidationResult
+		want    []string
+		notWant []string
+	}{
+		{
+			name:   "valid with network warnings",
+			result: validator.ValidationResult{IsValid: true, Warnings: []string{mx, bounty}},
+			want:   []string{"✓ SECURITY-INSIGHTS.yml is valid", "Warnings:", "  - " + mx, "  - " + bounty},
+		},
+		{
+			name:   "invalid with network warnings",
+			result: validator.ValidationResult{Errors: []string{"missing header"}, Warnings: []string{bounty}},
+			want:   []string{"✗ SECURITY-INSIGHTS.yml is invalid:", "  - missing header", "Warnings:", "  - " + bounty},
+		},
+		{
+			name:    "no warnings",
+			result:  validator.ValidationResult{IsValid: true},
+			want:    []string{"✓ SECURITY-INSIGHTS.yml is valid"},
+			notWant: []string{"Warnings:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printValidation(&buf, "SECURITY-INSIGHTS.yml", &tt.result)
+			output := buf.String()
+			for _, s := range tt.want {
+				if !strings.Contains(output, s) {
+					t.Errorf("output missing %q:\n%s", s, output)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(output, s) {
+					t.Errorf("output unexpectedly contains %q:\n%s", s, output)
+				}
+			}
+		})
+	}
+}
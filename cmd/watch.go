@@ -0,0 +1,230 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/github"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchCreateIssues bool
+	watchDryRun       bool
+	watchAPIURL       string
+)
+
+// watchIssueMarker identifies the tracking issue baseline-init files, so
+// later runs update it instead of opening another one
+const watchIssueMarker = "<!-- baseline-init:compliance -->"
+
+const watchIssueTitle = "OpenSSF Baseline: required files are missing"
+
+// watchFileActions explains how to add each required file in issue bodies
+var watchFileActions = map[string]string{
+	"SECURITY-INSIGHTS.yml": "Run `baseline-init setup --auto` to generate a SECURITY-INSIGHTS.yml",
+	"SECURITY.md":           "Run `baseline-init setup --auto` to generate a security policy",
+	"LICENSE":               "Add an appropriate open source license",
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch <org>",
+	Short: "Report baseline file coverage across a GitHub organization",
+	Long: `List the repositories of a GitHub organization and report which are missing
+the files the OpenSSF baseline requires (SECURITY-INSIGHTS.yml, SECURITY.md and
+LICENSE). Presence is checked through the GitHub API, so nothing is cloned.
+Archived repositories and forks are skipped.
+
+With --create-issues, a tracking issue is opened in every non-compliant
+repository listing what is missing. Later runs update that issue rather than
+opening a new one, close it once the repository is compliant, and reopen it if
+files go missing again. Filing issues requires GITHUB_TOKEN with permission to
+write issues.
+
+Use --dry-run to print the issues that would be opened, updated or closed
+without changing anything.
+
+Example:
+  baseline-init watch my-org
+  baseline-init watch my-org --dry-run
+  GITHUB_TOKEN=... baseline-init watch my-org --create-issues`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWatch,
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().BoolVar(&watchCreateIssues, "create-issues", false, "Open, update or close a tracking issue in each repository (requires GITHUB_TOKEN)")
+	watchCmd.Flags().BoolVar(&watchDryRun, "dry-run", false, "Print the issues that would be filed without changing anything")
+	watchCmd.Flags().StringVar(&watchAPIURL, "api-url", github.DefaultBaseURL, "GitHub API endpoint, for GitHub Enterprise Server")
+	watchCmd.MarkFlagsMutuallyExclusive("create-issues", "dry-run")
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	org := args[0]
+
+	client := github.NewClient()
+	client.SetBaseURL(watchAPIURL)
+	if watchCreateIssues && !client.HasToken() {
+		return fmt.Errorf("--create-issues requires GITHUB_TOKEN to be set")
+	}
+
+	repos, err := client.ListOrgRepositories(ctx, org)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories in %s: %w", org, err)
+	}
+
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	checked, failing := 0, 0
+	for _, repo := range repos {
+		if repo.Archived || repo.Fork {
+			continue
+		}
+		owner, name, _ := strings.Cut(repo.FullName, "/")
+
+		missing, err := remoteMissingFiles(ctx, client, owner, name)
+		if err != nil {
+			return fmt.Errorf("failed to check %s: %w", repo.FullName, err)
+		}
+		checked++
+
+		if len(missing) == 0 {
+			fmt.Printf("%s %s\n", green("✓"), repo.FullName)
+		} else {
+			failing++
+			fmt.Printf("%s %s: missing %s\n", red("✗"), repo.FullName, strings.Join(missing, ", "))
+		}
+
+		if watchCreateIssues || watchDryRun {
+			if err := syncTrackingIssue(ctx, client, owner, name, missing); err != nil {
+				return fmt.Errorf("failed to update tracking issue for %s: %w", repo.FullName, err)
+			}
+		}
+	}
+
+	fmt.Printf("\n%d of %d repositories in %s are missing required files\n", failing, checked, org)
+	return nil
+}
+
+// remoteMissingFiles lists the required files absent from the default
+// branch of owner/name, applying the same locations as a local check
+func remoteMissingFiles(ctx context.Context, client *github.Client, owner, name string) ([]string, error) {
+	dirs := make(map[string]bool)
+	for _, def := range checker.Checks {
+		if !def.Required {
+			continue
+		}
+		for _, location := range def.Locations {
+			dirs[path.Dir(location)] = true
+		}
+	}
+
+	present := make(map[string]bool)
+	for dir := range dirs {
+		if dir == "." {
+			dir = ""
+		}
+		entries, err := client.ListDirectory(ctx, owner, name, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			present[entry] = true
+		}
+	}
+
+	return checker.MissingRequired(func(relPath string) bool { return present[relPath] }), nil
+}
+
+// syncTrackingIssue brings the tracking issue of owner/name in line with
+// missing: opened or updated while files are missing, closed once none
+// are. A closed tracking issue is reopened when files go missing again,
+// rather than filing a duplicate. With --dry-run it only prints what it
+// would do.
+func syncTrackingIssue(ctx context.Context, client *github.Client, owner, name string, missing []string) error {
+	existing, err := client.FindIssue(ctx, owner, name, watchIssueMarker)
+	if err != nil {
+		return err
+	}
+
+	open := existing != nil && existing.State != "closed"
+	switch {
+	case len(missing) == 0 && !open:
+		return nil
+	case len(missing) == 0:
+		if watchDryRun {
+			fmt.Printf("    would close #%d\n", existing.Number)
+			return nil
+		}
+		if err := client.CloseIssue(ctx, owner, name, existing.Number); err != nil {
+			return err
+		}
+		fmt.Printf("    closed #%d\n", existing.Number)
+		return nil
+	}
+
+	body := watchIssueBody(missing)
+	if open && existing.Body == body && existing.Title == watchIssueTitle {
+		fmt.Printf("    #%d is up to date\n", existing.Number)
+		return nil
+	}
+
+	if watchDryRun {
+		switch {
+		case open:
+			fmt.Printf("    would update #%d:\n", existing.Number)
+		case existing != nil:
+			fmt.Printf("    would reopen #%d:\n", existing.Number)
+		default:
+			fmt.Printf("    would open issue %q:\n", watchIssueTitle)
+		}
+		for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+			fmt.Printf("      %s\n", line)
+		}
+		return nil
+	}
+
+	if existing != nil {
+		issue, err := client.UpdateIssue(ctx, owner, name, existing.Number, watchIssueTitle, body)
+		if err != nil {
+			return err
+		}
+		if open {
+			fmt.Printf("    updated %s\n", issue.HTMLURL)
+		} else {
+			fmt.Printf("    reopened %s\n", issue.HTMLURL)
+		}
+		return nil
+	}
+	issue, err := client.CreateIssue(ctx, owner, name, watchIssueTitle, body)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("    opened %s\n", issue.HTMLURL)
+	return nil
+}
+
+// watchIssueBody describes the missing files. It must be deterministic so
+// unchanged findings leave the existing issue untouched.
+func watchIssueBody(missing []string) string {
+	var b strings.Builder
+	b.WriteString(watchIssueMarker + "\n")
+	b.WriteString("This repository is missing files required by the [OpenSSF Security Baseline](https://github.com/ossf/security-baseline):\n\n")
+	for _, name := range missing {
+		fmt.Fprintf(&b, "- [ ] `%s`: %s\n", name, watchFileActions[name])
+	}
+	b.WriteString("\nRun `baseline-init check` in a clone of the repository for the full report. ")
+	b.WriteString("This issue is maintained by `baseline-init watch` and will be closed automatically once the files are added.\n")
+	return b.String()
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/github"
+)
+
+func TestWatch_SyncTrackingIssue(t *testing.T) {
+	missing := []string{"SECURITY.md"}
+	tracking := func(state string, missing []string) string {
+		body, _ := json.Marshal(watchIssueBody(missing))
+		return fmt.Sprintf(`[{"number": 7, "title": %q, "body": %s, "state": %q}]`, watchIssueTitle, body, state)
+	}
+
+	tests := []struct {
+		name     string
+		existing string
+		missing  []string
+		want     []string
+	}{
+		{name: "no issue, compliant", existing: `[]`, want: nil},
+		{name: "no issue, files missing", existing: `[]`, missing: missing, want: []string{"POST /repos/example/repo/issues"}},
+		{name: "open issue, compliant", existing: tracking("open", missing), want: []string{"PATCH /repos/example/repo/issues/7 closed"}},
+		{name: "open issue, up to date", existing: tracking("open", missing), missing: missing, want: nil},
+		{name: "open issue, changed", existing: tracking("open", []string{"LICENSE"}), missing: missing, want: []string{"PATCH /repos/example/repo/issues/7 open"}},
+		{name: "closed issue, compliant", existing: tracking("closed", missing), want: nil},
+		{name: "closed issue, files missing again", existing: tracking("closed", missing), missing: missing, want: []string{"PATCH /repos/example/repo/issues/7 open"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var writes []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					w.Write([]byte(tt.existing))
+					return
+				}
+				var request map[string]string
+				json.NewDecoder(r.Body).Decode(&request)
+				write := r.Method + " " + r.URL.Path
+				if state := request["state"]; state != "" {
+					write += " " + state
+				}
+				writes = append(writes, write)
+				w.Write([]byte(`{"number": 7, "html_url": "https://github.com/example/repo/issues/7"}`))
+			}))
+			t.Cleanup(server.Close)
+
+			client := github.NewClient()
+			client.SetBaseURL(server.URL)
+			if err := syncTrackingIssue(context.Background(), client, "example", "repo", tt.missing); err != nil {
+				t.Fatalf("syncTrackingIssue() error = %v", err)
+			}
+			if !reflect.DeepEqual(writes, tt.want) {
+				t.Errorf("writes = %v, want %v", writes, tt.want)
+			}
+		})
+	}
+}
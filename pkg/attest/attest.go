@@ -0,0 +1,73 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package attest builds in-toto statements recording a repository's OpenSSF
+// baseline compliance at a commit. Statements are left unsigned so they can
+// be wrapped in a DSSE envelope by existing signing tools.
+package attest
+
+import (
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+const (
+	// StatementType is the in-toto Statement layer version emitted
+	StatementType = "https://in-toto.io/Statement/v1"
+
+	// PredicateType identifies the baseline compliance predicate
+	PredicateType = "https://github.com/aguamala/baseline-init/compliance/v1"
+)
+
+// Statement is an in-toto v1 statement about a repository at a commit
+type Statement struct {
+	Type          string    `json:"_type"`
+	Subject       []Subject `json:"subject"`
+	PredicateType string    `json:"predicateType"`
+	Predicate     Predicate `json:"predicate"`
+}
+
+// Subject identifies the attested artifact: the repository at a commit
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Predicate records which baseline requirements the repository met
+type Predicate struct {
+	Checker   Checker              `json:"checker"`
+	CheckedAt string               `json:"checkedAt"`
+	Compliant bool                 `json:"compliant"`
+	Score     int                  `json:"score"`
+	Result    *checker.CheckResult `json:"result"`
+}
+
+// Checker identifies the tool that produced the predicate
+type Checker struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// New creates a statement for result, naming the repository and the commit
+// it was checked at
+func New(result *checker.CheckResult, repo, commit, version string, checkedAt time.Time) *Statement {
+	return &Statement{
+		Type: StatementType,
+		Subject: []Subject{{
+			Name:   repo,
+			Digest: map[string]string{"gitCommit": commit},
+		}},
+		PredicateType: PredicateType,
+		Predicate: Predicate{
+			Checker: Checker{
+				Name:    "baseline-init",
+				Version: version,
+			},
+			CheckedAt: checkedAt.UTC().Format(time.RFC3339),
+			Compliant: result.IsCompliant,
+			Score:     result.Score,
+			Result:    result,
+		},
+	}
+}
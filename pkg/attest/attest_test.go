@@ -0,0 +1,61 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestAttest_New(t *testing.T) {
+	result := &checker.CheckResult{
+		Path:        ".",
+		IsCompliant: true,
+		Files: []checker.FileCheck{
+			{Name: "SECURITY-INSIGHTS.yml", Exists: true, Valid: true},
+			{Name: "SECURITY.md", Exists: true, Valid: true},
+		},
+	}
+	result.UpdateScore()
+	checkedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	statement := New(result, "github.com/example/repo", "0123abcd", "1.2.3", checkedAt)
+
+	data, err := json.Marshal(statement)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if decoded["_type"] != StatementType {
+		t.Errorf("_type = %v, want %s", decoded["_type"], StatementType)
+	}
+	if decoded["predicateType"] != PredicateType {
+		t.Errorf("predicateType = %v, want %s", decoded["predicateType"], PredicateType)
+	}
+
+	subjects := decoded["subject"].([]interface{})
+	subject := subjects[0].(map[string]interface{})
+	if subject["name"] != "github.com/example/repo" {
+		t.Errorf("subject name = %v", subject["name"])
+	}
+	if digest := subject["digest"].(map[string]interface{}); digest["gitCommit"] != "0123abcd" {
+		t.Errorf("subject digest = %v, want gitCommit 0123abcd", digest)
+	}
+
+	predicate := decoded["predicate"].(map[string]interface{})
+	if predicate["checkedAt"] != "2025-03-01T12:00:00Z" {
+		t.Errorf("checkedAt = %v", predicate["checkedAt"])
+	}
+	if predicate["compliant"] != true || predicate["score"] != float64(100) {
+		t.Errorf("compliant = %v, score = %v, want true and 100", predicate["compliant"], predicate["score"])
+	}
+}
@@ -4,22 +4,175 @@
 package checker
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/config"
+	"github.com/aguamala/baseline-init/pkg/github"
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+	"github.com/aguamala/baseline-init/pkg/manifest"
+	"github.com/aguamala/baseline-init/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+// Thresholds used to sanity-check the declared lifecycle status against
+// repository activity
+const (
+	staleActivityThreshold  = 365 * 24 * time.Hour
+	recentActivityThreshold = 30 * 24 * time.Hour
 )
 
+// githubLookupTimeout bounds the GitHub API call made for archival status
+const githubLookupTimeout = 15 * time.Second
+
+// archivalMarkers are files whose presence marks a repository as archived
+// when no hosting platform signal is available
+var archivalMarkers = []string{".archived", "ARCHIVED", "ARCHIVED.md"}
+
+// DefaultFundingAdminThreshold is the administrator count from which an
+// active project is considered substantial enough to need a funding signal
+const DefaultFundingAdminThreshold = 3
+
+// An author is prolific enough to be expected among the declared owners once
+// they have at least prolificMinCommits commits and prolificCommitShare of all
+const (
+	prolificMinCommits  = 20
+	prolificCommitShare = 0.25
+)
+
+// ownershipWindowMonths is how far back commit authors are compared with
+// the declared owners, so that long-gone contributors do not count
+const ownershipWindowMonths = 12
+
+// fundingFiles are the places a funding or sustainability note is expected
+var fundingFiles = []string{
+	filepath.Join(".github", "FUNDING.yml"),
+	"FUNDING.yml",
+	"SUSTAINABILITY.md",
+}
+
+// ReportVersion identifies the layout of CheckResult in machine-readable
+// output. Bump it when a field is removed, renamed or changes meaning, so
+// consumers can tell which layout they are reading; adding a field does not
+// require a bump.
+const ReportVersion = "1"
+
 // Checker performs OpenSSF baseline compliance checks
 type Checker struct {
-	repoPath string
+	repoPath              string
+	githubClient          *github.Client
+	fundingAdminThreshold int
+	// level is the highest baseline maturity level whose requirements are
+	// checked
+	level int
+	// checks are the file checks to run: Checks, as tailored by SetConfig
+	// and SelectChecks
+	checks []CheckDefinition
+	// skipped holds the IDs of the RepositoryChecks left out by SelectChecks
+	skipped map[string]bool
+	// remoteURL is the repository's URL when it is checked over the API
+	// rather than from a clone with a git remote
+	remoteURL string
+	// progress is called after each project of a recursive check
+	progress func(done, total int, path string)
 }
 
 // CheckResult contains the results of a compliance check
 type CheckResult struct {
+	ReportVersion string             `json:"report_version" yaml:"report_version"`
 	Path          string             `json:"path"`
 	IsCompliant   bool               `json:"is_compliant"`
 	Files         []FileCheck        `json:"files"`
 	MissingFiles  []string           `json:"missing_files"`
 	Recommendations []Recommendation `json:"recommendations"`
+	// Checks holds the outcome of each rule by its stable ID, such as
+	// "license-present", for integrators that should not match on names
+	Checks        map[string]CheckOutcome `json:"checks" yaml:"checks"`
+	Partial       bool               `json:"partial,omitempty" yaml:"partial,omitempty"` // check stopped early, e.g. on timeout
+	// Level is the baseline maturity level checked against; requirements of
+	// higher levels are left out of the result
+	Level int `json:"level" yaml:"level"`
+	// Score is EarnedScore as a percentage (0-100) of MaxScore; see
+	// UpdateScore for the weighting
+	Score       int `json:"score" yaml:"score"`
+	MaxScore    int `json:"max_score" yaml:"max_score"`
+	EarnedScore int `json:"earned_score" yaml:"earned_score"`
+}
+
+// priorityWeights is what a file counts towards the score, by the priority
+// of its check in Checks
+var priorityWeights = map[string]int{
+	"critical": 4,
+	"high":     3,
+	"medium":   2,
+	"low":      1,
+}
+
+// UpdateScore computes the score from the file checks. Each checked file is
+// worth the weight of its priority, earned in full when it is present and
+// valid and not at all otherwise; files without a rule are worth the low
+// weight. The priority is the severity of the file's "<file>-present" rule,
+// so a config's overrides carry over. The result only depends on Files and
+// Checks, so it is the same for a result read back from a JSON report.
+func (r *CheckResult) UpdateScore() {
+	r.MaxScore, r.EarnedScore, r.Score = 0, 0, 0
+	for _, file := range r.Files {
+		weight := priorityWeights["low"]
+		if def, ok := checkFor(file.Name); ok {
+			weight = priorityWeights[def.Priority]
+			if outcome, ok := r.Checks[def.ID+"-present"]; ok {
+				weight = priorityWeights[outcome.Severity]
+			}
+		}
+
+		r.MaxScore += weight
+		if file.Exists && file.Valid {
+			r.EarnedScore += weight
+		}
+	}
+	if r.MaxScore > 0 {
+		r.Score = r.EarnedScore * 100 / r.MaxScore
+	}
+}
+
+// PromoteWarnings turns each file's warnings into errors, prefixed
+// "warning: " so they stay recognizable, marking those files invalid and the
+// result non-compliant. Their "<file>-valid" rules fail and the score is
+// recomputed. It reports whether anything was promoted.
+func (r *CheckResult) PromoteWarnings() bool {
+	promoted := false
+	for i := range r.Files {
+		file := &r.Files[i]
+		if len(file.Warnings) == 0 {
+			continue
+		}
+		for _, w := range file.Warnings {
+			file.Errors = append(file.Errors, "warning: "+w)
+		}
+		file.Warnings = nil
+		file.Valid = false
+		promoted = true
+
+		// The outcome keeps the severity and level it was checked with
+		if def, ok := checkFor(file.Name); ok {
+			if outcome, ok := r.Checks[def.ID+"-valid"]; ok {
+				outcome.Status = StatusFail
+				outcome.Messages = append([]string{}, file.Errors...)
+				r.Checks[def.ID+"-valid"] = outcome
+			}
+		}
+	}
+	if promoted {
+		r.IsCompliant = false
+		r.UpdateScore()
+	}
+	return promoted
 }
 
 // FileCheck represents the status of a compliance file
@@ -28,236 +181,1105 @@ type FileCheck struct {
 	Path     string   `json:"path"`
 	Exists   bool     `json:"exists"`
 	Valid    bool     `json:"valid"`
+	Detected string   `json:"detected,omitempty" yaml:"detected,omitempty"` // SPDX identifier of a recognized license, or the dependency update tool
 	Errors   []string `json:"errors,omitempty"`
 	Warnings []string `json:"warnings,omitempty"`
 }
 
 // Recommendation provides actionable guidance
 type Recommendation struct {
-	Priority    string `json:"priority"` // critical, high, medium, low
+	ID          string `json:"id" yaml:"id"` // stable identifier such as BASELINE-SEC-001
+	Priority    string `json:"priority"`     // critical, high, medium, low
 	Category    string `json:"category"`
 	Description string `json:"description"`
 	Action      string `json:"action"`
+	Reference   string `json:"reference" yaml:"reference"`           // URL of the document behind the recommendation
+	Effort      string `json:"effort" yaml:"effort"`                 // auto, manual, policy
+	File        string `json:"file,omitempty" yaml:"file,omitempty"` // name of the FileCheck this concerns, if any
+	Level       int    `json:"level" yaml:"level"`                   // baseline maturity level that requires it
+	// AutoFixable is true when 'setup --auto' resolves the recommendation,
+	// which is when its Effort is "auto". The checker derives it from Effort.
+	AutoFixable bool `json:"auto_fixable" yaml:"auto_fixable"`
 }
 
 // New creates a new Checker instance
 func New(repoPath string) *Checker {
 	return &Checker{
-		repoPath: repoPath,
+		repoPath:              repoPath,
+		fundingAdminThreshold: DefaultFundingAdminThreshold,
+		level:                 MaxLevel,
+		checks:                Checks,
+	}
+}
+
+// SetConfig applies a config's overrides of which files are required and
+// their priorities. It fails on files that are not checked and on unknown
+// priorities, leaving the checks unchanged.
+func (c *Checker) SetConfig(cfg *config.Config) error {
+	checks := append([]CheckDefinition{}, Checks...)
+	for name, file := range cfg.Files {
+		i := slices.IndexFunc(checks, func(def CheckDefinition) bool { return def.File == name })
+		if i < 0 {
+			return fmt.Errorf("config: %s is not a checked file", name)
+		}
+		if file.Required != nil {
+			checks[i].Required = *file.Required
+		}
+		if file.Priority != "" {
+			if _, ok := priorityWeights[file.Priority]; !ok {
+				return fmt.Errorf("config: invalid priority %q for %s", file.Priority, name)
+			}
+			checks[i].Priority = file.Priority
+		}
+	}
+	c.checks = checks
+	return nil
+}
+
+// SelectChecks limits the file and repository checks to those whose IDs are
+// in only, when it is not empty, and not in skip. Call it after SetConfig,
+// which starts again from every file check.
+func (c *Checker) SelectChecks(only, skip []string) error {
+	for _, id := range append(append([]string{}, only...), skip...) {
+		if !slices.ContainsFunc(Checks, func(def CheckDefinition) bool { return def.ID == id }) &&
+			!slices.ContainsFunc(RepositoryChecks, func(check RepositoryCheck) bool { return check.ID == id }) {
+			return fmt.Errorf("unknown check ID %q (see 'baseline-init list-checks')", id)
+		}
+	}
+	selected := func(id string) bool {
+		return (len(only) == 0 || slices.Contains(only, id)) && !slices.Contains(skip, id)
+	}
+
+	var checks []CheckDefinition
+	for _, def := range c.checks {
+		if selected(def.ID) {
+			checks = append(checks, def)
+		}
+	}
+	c.checks = checks
+
+	c.skipped = map[string]bool{}
+	for _, check := range RepositoryChecks {
+		if !selected(check.ID) {
+			c.skipped[check.ID] = true
+		}
 	}
+	return nil
+}
+
+// SetLevel limits the check to the requirements of baseline maturity levels
+// 1 through level. Compliance is then judged at that level.
+func (c *Checker) SetLevel(level int) {
+	c.level = level
+}
+
+// SetFundingAdminThreshold sets how many administrators an active project
+// needs before a missing funding signal is reported. Zero disables the check.
+func (c *Checker) SetFundingAdminThreshold(threshold int) {
+	c.fundingAdminThreshold = threshold
+}
+
+// SetGitHubClient enables checks that query the GitHub API, such as
+// comparing the declared status with the repository's archived flag
+func (c *Checker) SetGitHubClient(client *github.Client) {
+	c.githubClient = client
 }
 
 // Check performs a compliance check on the repository
 func (c *Checker) Check() (*CheckResult, error) {
+	return c.CheckContext(context.Background())
+}
+
+// CheckContext performs a compliance check that stops starting new checks
+// once ctx is done, returning the results gathered so far marked as partial
+func (c *Checker) CheckContext(ctx context.Context) (*CheckResult, error) {
 	result := &CheckResult{
+		ReportVersion: ReportVersion,
 		Path:          c.repoPath,
 		Files:         []FileCheck{},
 		MissingFiles:  []string{},
 		Recommendations: []Recommendation{},
 	}
 
-	// Check for SECURITY-INSIGHTS.yml
-	siCheck := c.checkSecurityInsights()
-	result.Files = append(result.Files, siCheck)
-	if !siCheck.Exists {
-		result.MissingFiles = append(result.MissingFiles, "SECURITY-INSIGHTS.yml")
-		result.Recommendations = append(result.Recommendations, Recommendation{
-			Priority:    "high",
-			Category:    "Security Metadata",
-			Description: "SECURITY-INSIGHTS.yml file is missing",
-			Action:      "Run 'baseline-init setup --auto' to generate this file",
-		})
+	if ctx.Err() != nil {
+		return c.partialResult(result), nil
 	}
 
-	// Check for SECURITY.md
-	securityMdCheck := c.checkSecurityPolicy()
-	result.Files = append(result.Files, securityMdCheck)
-	if !securityMdCheck.Exists {
-		result.MissingFiles = append(result.MissingFiles, "SECURITY.md")
-		result.Recommendations = append(result.Recommendations, Recommendation{
-			Priority:    "medium",
-			Category:    "Security Policy",
-			Description: "SECURITY.md file is missing",
-			Action:      "Create a SECURITY.md file documenting your security policy",
-		})
+	var declared declaredInsights
+	for _, def := range c.checks {
+		if ctx.Err() != nil {
+			return c.partialResult(result), nil
+		}
+
+		file := c.inspect(def)
+		if file.Exists {
+			c.review(ctx, def, &file, &declared, result)
+		}
+		result.Files = append(result.Files, file)
+		if !file.Exists {
+			if def.Required {
+				result.MissingFiles = append(result.MissingFiles, def.File)
+			}
+			result.Recommendations = append(result.Recommendations, def.missingRecommendation())
+		}
 	}
 
-	// Check for LICENSE file
-	licenseCheck := c.checkLicense()
-	result.Files = append(result.Files, licenseCheck)
-	if !licenseCheck.Exists {
-		result.MissingFiles = append(result.MissingFiles, "LICENSE")
-		result.Recommendations = append(result.Recommendations, Recommendation{
-			Priority:    "high",
-			Category:    "Legal",
-			Description: "LICENSE file is missing",
-			Action:      "Add an appropriate open source license to your repository",
-		})
+	if ctx.Err() != nil {
+		return c.partialResult(result), nil
+	}
+
+	// Detect manual changes to generated files
+	if !c.skipped["manifest"] {
+		c.checkManifest(result)
+	}
+
+	if ctx.Err() != nil {
+		return c.partialResult(result), nil
+	}
+
+	// Flag compliance files that others could tamper with
+	if !c.skipped["file-modes"] {
+		c.checkFileModes(result)
+	}
+
+	if ctx.Err() != nil {
+		return c.partialResult(result), nil
+	}
+
+	// Flag compliance files that exist locally but will not ship with the repo
+	if !c.skipped["tracking"] {
+		c.checkTracking(result)
+	}
+
+	// Determine overall compliance at the checked level
+	c.applyLevel(result)
+	result.IsCompliant = len(result.MissingFiles) == 0 && insightsValid(result.Files)
+	result.Checks = ruleOutcomes(c.checks, result.Files)
+	result.UpdateScore()
+
+	return result, nil
+}
+
+// partialResult marks a result whose check was cut short. Compliance cannot
+// be established from a partial check, so it is reported as not compliant.
+func (c *Checker) partialResult(result *CheckResult) *CheckResult {
+	c.applyLevel(result)
+	result.Partial = true
+	result.IsCompliant = false
+	result.Checks = ruleOutcomes(c.checks, result.Files)
+	result.UpdateScore()
+	return result
+}
+
+// fileLevel is FileLevel for the checker's own, possibly tailored, checks
+func (c *Checker) fileLevel(file string) int {
+	for _, def := range c.checks {
+		if def.File == file {
+			return def.Level
+		}
+	}
+	return FileLevel(file)
+}
+
+// applyLevel records the checked level and each recommendation's level and
+// AutoFixable, and drops the files and recommendations that only higher
+// levels require
+func (c *Checker) applyLevel(result *CheckResult) {
+	result.Level = c.level
+	within := func(file string) bool { return c.fileLevel(file) <= c.level }
+
+	files := result.Files[:0]
+	for _, file := range result.Files {
+		if within(file.Name) {
+			files = append(files, file)
+		}
+	}
+	result.Files = files
+
+	missing := result.MissingFiles[:0]
+	for _, name := range result.MissingFiles {
+		if within(name) {
+			missing = append(missing, name)
+		}
+	}
+	result.MissingFiles = missing
+
+	recommendations := result.Recommendations[:0]
+	for _, rec := range result.Recommendations {
+		rec.Level = c.fileLevel(rec.File)
+		rec.AutoFixable = rec.Effort == "auto"
+		if rec.Level <= c.level {
+			recommendations = append(recommendations, rec)
+		}
+	}
+	result.Recommendations = recommendations
+}
+
+// checkRemoteURL warns when the repository URL declared in SECURITY-INSIGHTS.yml
+// (repository.url, or header.project-url in 1.0.0 files) does not match the
+// repository's git remote, which usually means the repository moved or a
+// fork kept its upstream's metadata. Repositories without a remote are
+// skipped.
+func (c *Checker) checkRemoteURL(siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	remote, err := c.detectRemote()
+	if err != nil || remote == "" {
+		return
+	}
+
+	if declared.URL == "" || gitutil.NormalizeURL(declared.URL) == gitutil.NormalizeURL(remote) {
+		return
+	}
+
+	siCheck.Warnings = append(siCheck.Warnings,
+		fmt.Sprintf("Declared %s %s does not match git remote %s", declared.URLField, declared.URL, remote))
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsRemoteMismatch,
+		Priority:    "medium",
+		Category:    "Security Metadata",
+		Description: fmt.Sprintf("SECURITY-INSIGHTS.yml %s does not match the git remote", declared.URLField),
+		Action:      fmt.Sprintf("Update %s to %s if the repository has moved or is a fork", declared.URLField, remote),
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        siCheck.Name,
+	})
+}
+
+// checkHeaderURL warns when header.url points somewhere other than the
+// repository, such as a marketing homepage. It is advisory only, since some
+// projects intentionally use a project-level URL there.
+func (c *Checker) checkHeaderURL(siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	if declared.HeaderURL == "" {
+		return
+	}
+
+	header := gitutil.NormalizeURL(declared.HeaderURL)
+	if declared.URL != "" && header == gitutil.NormalizeURL(declared.URL) {
+		// Same as repository.url, which checkRemoteURL already covers
+		return
+	}
+
+	remote, err := c.detectRemote()
+	if err != nil || remote == "" {
+		return
+	}
+
+	normalizedRemote := gitutil.NormalizeURL(remote)
+	if header == normalizedRemote || strings.HasPrefix(header, normalizedRemote+"/") {
+		return
+	}
+
+	siCheck.Warnings = append(siCheck.Warnings,
+		fmt.Sprintf("header.url %s does not point to the repository (git remote %s)", declared.HeaderURL, remote))
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsHeaderURL,
+		Priority:    "low",
+		Category:    "Security Metadata",
+		Description: "SECURITY-INSIGHTS.yml header.url does not point to the repository",
+		Action:      fmt.Sprintf("Confirm header.url is intended; it usually matches %s", remote),
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        siCheck.Name,
+	})
+}
+
+// checkLifecycleStatus compares the declared lifecycle status with the date of
+// the last commit: an "active" project with no commits for a long time, or an
+// "archived" one that is still receiving commits, is probably mislabeled
+func (c *Checker) checkLifecycleStatus(siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	if declared.Status != "active" && declared.Status != "archived" {
+		return
+	}
+
+	lastCommit, err := gitutil.LastCommitTime(c.repoPath)
+	if err != nil {
+		return
+	}
+	age := time.Since(lastCommit)
+
+	var warning string
+	switch {
+	case declared.Status == "active" && age > staleActivityThreshold:
+		warning = fmt.Sprintf("Status is \"active\" but the last commit was on %s", lastCommit.Format("2006-01-02"))
+	case declared.Status == "archived" && age < recentActivityThreshold:
+		warning = fmt.Sprintf("Status is \"archived\" but there was a commit on %s", lastCommit.Format("2006-01-02"))
+	default:
+		return
+	}
+
+	siCheck.Warnings = append(siCheck.Warnings, warning)
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsStaleStatus,
+		Priority:    "low",
+		Category:    "Security Metadata",
+		Description: "Declared project status may not reflect recent activity",
+		Action:      "Review the status field in SECURITY-INSIGHTS.yml",
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        siCheck.Name,
+	})
+}
+
+// checkArchivalStatus compares the declared status with the platform's
+// archived state. The check only runs when that state can be determined:
+// from the GitHub API when a client is set, otherwise from a local marker
+// file, whose absence says nothing either way.
+func (c *Checker) checkArchivalStatus(ctx context.Context, siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	if declared.Status == "" {
+		return
+	}
+
+	archived, source, known := c.platformArchived(ctx, declared)
+	if !known {
+		return
+	}
+
+	var warning string
+	switch {
+	case archived && declared.Status != "archived":
+		warning = fmt.Sprintf("Repository is archived (%s) but status is %q", source, declared.Status)
+	case !archived && declared.Status == "archived":
+		warning = fmt.Sprintf("Status is \"archived\" but the repository is not archived (%s)", source)
+	default:
+		return
+	}
+
+	siCheck.Warnings = append(siCheck.Warnings, warning)
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsArchivalStatus,
+		Priority:    "medium",
+		Category:    "Security Metadata",
+		Description: "Declared project status does not match the repository's archival state",
+		Action:      "Update the status field in SECURITY-INSIGHTS.yml or the repository's archived setting",
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        siCheck.Name,
+	})
+}
+
+// checkFunding recommends a funding or sustainability signal for active
+// projects that look substantial, judged by their administrator count. It is
+// advisory: a missing funding model is a project health risk, not a defect.
+func (c *Checker) checkFunding(siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	if c.fundingAdminThreshold <= 0 || declared.Status != "active" ||
+		declared.Administrators < c.fundingAdminThreshold || declared.Funding != "" {
+		return
+	}
+
+	for _, name := range fundingFiles {
+		if _, err := os.Stat(filepath.Join(c.repoPath, name)); err == nil {
+			return
+		}
+	}
+
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecFundingMissing,
+		Priority:    "low",
+		Category:    "Sustainability",
+		Description: fmt.Sprintf("Active project with %d administrators declares no funding model", declared.Administrators),
+		Action:      "Add .github/FUNDING.yml, a SUSTAINABILITY.md note, or set project.funding in SECURITY-INSIGHTS.yml",
+		Reference:   insightsReference,
+		Effort:      "policy",
+		File:        siCheck.Name,
+	})
+}
+
+// checkOwnership compares the declared administrators and core team against
+// the repository's commit authors. Owners with no commits may be stale, and
+// prolific authors who are not listed may be missing. Only recent commits
+// count. It is advisory and skipped outside a git repository and in shallow
+// clones, whose truncated history would make every owner look stale.
+func (c *Checker) checkOwnership(siCheck *FileCheck, declared declaredInsights, result *CheckResult) {
+	if len(declared.Owners) == 0 {
+		return
+	}
+	if shallow, err := gitutil.IsShallow(c.repoPath); err != nil || shallow {
+		return
+	}
+	since := time.Now().AddDate(0, -ownershipWindowMonths, 0)
+	authors, err := gitutil.CommitAuthors(c.repoPath, since)
+	if err != nil || len(authors) == 0 {
+		return
+	}
+
+	var stale []string
+	for _, owner := range declared.Owners {
+		found := false
+		for _, author := range authors {
+			if owner.matches(author) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			stale = append(stale, owner.Name)
+		}
 	}
 
-	// Check for CODE_OF_CONDUCT.md
-	cocCheck := c.checkCodeOfConduct()
-	result.Files = append(result.Files, cocCheck)
-	if !cocCheck.Exists {
+	total := 0
+	for _, author := range authors {
+		total += author.Commits
+	}
+	var unlisted []string
+	for _, author := range authors {
+		if author.Commits < prolificMinCommits || float64(author.Commits) < prolificCommitShare*float64(total) ||
+			strings.Contains(author.Name, "[bot]") {
+			continue
+		}
+		listed := false
+		for _, owner := range declared.Owners {
+			if owner.matches(author) {
+				listed = true
+				break
+			}
+		}
+		if !listed {
+			unlisted = append(unlisted, author.Name)
+		}
+	}
+
+	if len(stale) > 0 {
+		siCheck.Warnings = append(siCheck.Warnings,
+			fmt.Sprintf("Declared owners with no commits in the last %d months: %s", ownershipWindowMonths, strings.Join(stale, ", ")))
 		result.Recommendations = append(result.Recommendations, Recommendation{
-			Priority:    "medium",
-			Category:    "Community",
-			Description: "CODE_OF_CONDUCT.md file is missing",
-			Action:      "Consider adding a code of conduct for contributors",
+			ID:          RecOwnersWithoutCommits,
+			Priority:    "low",
+			Category:    "Ownership",
+			Description: "Some declared administrators or core team members have no recent commits",
+			Action:      fmt.Sprintf("Confirm that %s still maintain the project, or update the administrators and core team", strings.Join(stale, ", ")),
+			Reference:   insightsReference,
+			Effort:      "manual",
+			File:        siCheck.Name,
 		})
 	}
-
-	// Check for CONTRIBUTING.md
-	contributingCheck := c.checkContributing()
-	result.Files = append(result.Files, contributingCheck)
-	if !contributingCheck.Exists {
+	if len(unlisted) > 0 {
+		siCheck.Warnings = append(siCheck.Warnings,
+			fmt.Sprintf("Frequent committers not listed as owners: %s", strings.Join(unlisted, ", ")))
 		result.Recommendations = append(result.Recommendations, Recommendation{
+			ID:          RecCommittersNotListed,
 			Priority:    "low",
-			Category:    "Community",
-			Description: "CONTRIBUTING.md file is missing",
-			Action:      "Consider adding contribution guidelines",
+			Category:    "Ownership",
+			Description: "Frequent committers are not listed in the core team",
+			Action:      fmt.Sprintf("Consider adding %s to repository.core-team", strings.Join(unlisted, ", ")),
+			Reference:   insightsReference,
+			Effort:      "manual",
+			File:        siCheck.Name,
 		})
 	}
+}
 
-	// Determine overall compliance
-	result.IsCompliant = len(result.MissingFiles) == 0
+// matches reports whether a commit author is plausibly this contact, by
+// email, by name, or by the handle in their social profile or a GitHub
+// noreply address
+func (d declaredContact) matches(author gitutil.Author) bool {
+	if d.Email != "" && strings.EqualFold(d.Email, author.Email) {
+		return true
+	}
+	if normalizeName(d.Name) == normalizeName(author.Name) {
+		return true
+	}
 
-	return result, nil
+	social := strings.TrimSuffix(d.Social, "/")
+	handle := strings.ToLower(strings.TrimPrefix(social[strings.LastIndex(social, "/")+1:], "@"))
+	if handle == "" {
+		return false
+	}
+	local, domain, _ := strings.Cut(strings.ToLower(author.Email), "@")
+	if domain == "users.noreply.github.com" {
+		if _, name, ok := strings.Cut(local, "+"); ok {
+			local = name
+		}
+	}
+	return handle == local || handle == normalizeName(author.Name)
 }
 
-// checkSecurityInsights checks for SECURITY-INSIGHTS.yml file
-func (c *Checker) checkSecurityInsights() FileCheck {
-	possiblePaths := []string{
-		filepath.Join(c.repoPath, "SECURITY-INSIGHTS.yml"),
-		filepath.Join(c.repoPath, ".github", "SECURITY-INSIGHTS.yml"),
-		filepath.Join(c.repoPath, "SECURITY-INSIGHTS.yaml"),
-		filepath.Join(c.repoPath, ".github", "SECURITY-INSIGHTS.yaml"),
-	}
+// normalizeName lowercases a name and drops whitespace so that "Jane Doe"
+// and "janedoe" compare equal
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
 
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); err == nil {
-			return FileCheck{
-				Name:   "SECURITY-INSIGHTS.yml",
-				Path:   path,
-				Exists: true,
-				Valid:  true, // TODO: Add actual validation
+// platformArchived reports whether the repository is archived and where
+// that came from. known is false when no signal is available.
+func (c *Checker) platformArchived(ctx context.Context, declared declaredInsights) (archived bool, source string, known bool) {
+	if c.githubClient != nil {
+		if owner, name, ok := c.githubRepository(declared); ok {
+			ctx, cancel := context.WithTimeout(ctx, githubLookupTimeout)
+			defer cancel()
+
+			if repo, err := c.githubClient.GetRepository(ctx, owner, name); err == nil {
+				return repo.Archived, "GitHub", true
 			}
 		}
 	}
 
-	return FileCheck{
-		Name:   "SECURITY-INSIGHTS.yml",
-		Path:   "",
-		Exists: false,
-		Valid:  false,
+	for _, marker := range archivalMarkers {
+		if _, err := os.Stat(filepath.Join(c.repoPath, marker)); err == nil {
+			return true, marker + " marker", true
+		}
 	}
+
+	return false, "", false
 }
 
-// checkSecurityPolicy checks for SECURITY.md file
-func (c *Checker) checkSecurityPolicy() FileCheck {
-	possiblePaths := []string{
-		filepath.Join(c.repoPath, "SECURITY.md"),
-		filepath.Join(c.repoPath, ".github", "SECURITY.md"),
-		filepath.Join(c.repoPath, "docs", "SECURITY.md"),
+// detectRemote returns the URL of the repository checked over the API, or
+// else the repository's git remote
+func (c *Checker) detectRemote() (string, error) {
+	if c.remoteURL != "" {
+		return c.remoteURL, nil
 	}
+	return gitutil.DetectRemote(c.repoPath)
+}
 
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); err == nil {
-			return FileCheck{
-				Name:   "SECURITY.md",
-				Path:   path,
-				Exists: true,
-				Valid:  true,
+// githubRepository identifies the repository on GitHub from its git remote,
+// falling back to the URL declared in the insights file
+func (c *Checker) githubRepository(declared declaredInsights) (owner, name string, ok bool) {
+	repoURL, err := c.detectRemote()
+	if err != nil || repoURL == "" {
+		repoURL = declared.URL
+	}
+	return github.ParseRepoURL(repoURL)
+}
+
+// privateReportingMentions are phrases in SECURITY.md that show reporters
+// are pointed at GitHub private vulnerability reporting
+var privateReportingMentions = []string{"/security/advisories/new", "private vulnerability reporting", "report a vulnerability"}
+
+// checkPrivateReporting recommends GitHub private vulnerability reporting for
+// active projects hosted on GitHub. With the GitHub API it checks the
+// repository setting; otherwise it looks for SECURITY.md pointing reporters
+// at it.
+func (c *Checker) checkPrivateReporting(ctx context.Context, securityMd *FileCheck, declared declaredInsights, result *CheckResult) {
+	if declared.Status != "active" {
+		return
+	}
+	owner, name, ok := c.githubRepository(declared)
+	if !ok {
+		return
+	}
+
+	if c.githubClient != nil {
+		lookupCtx, cancel := context.WithTimeout(ctx, githubLookupTimeout)
+		defer cancel()
+		if enabled, err := c.githubClient.PrivateVulnerabilityReporting(lookupCtx, owner, name); err == nil {
+			if !enabled {
+				result.Recommendations = append(result.Recommendations, Recommendation{
+					ID:          RecPrivateReportingOff,
+					Priority:    "medium",
+					Category:    "Security Policy",
+					Description: "GitHub private vulnerability reporting is disabled",
+					Action:      fmt.Sprintf("Enable private vulnerability reporting under Settings → Code security for %s/%s, then mention it in SECURITY.md", owner, name),
+					Reference:   baselineReference,
+					Effort:      "manual",
+					File:        securityMd.Name,
+				})
 			}
+			return
 		}
 	}
 
-	return FileCheck{
-		Name:   "SECURITY.md",
-		Path:   "",
-		Exists: false,
-		Valid:  false,
+	data, err := os.ReadFile(securityMd.Path)
+	if err != nil {
+		return
+	}
+	content := strings.ToLower(string(data))
+	for _, mention := range privateReportingMentions {
+		if strings.Contains(content, mention) {
+			return
+		}
 	}
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecPrivateReportingUnnamed,
+		Priority:    "low",
+		Category:    "Security Policy",
+		Description: "SECURITY.md does not offer GitHub private vulnerability reporting",
+		Action:      fmt.Sprintf("Enable private vulnerability reporting for %s/%s and regenerate SECURITY.md with --set private-reporting=true, or link https://github.com/%s/%s/security/advisories/new", owner, name, owner, name),
+		Reference:   baselineReference,
+		Effort:      "manual",
+		File:        securityMd.Name,
+	})
+}
+
+// declaredInsights holds the fields of an insights file used by cross-checks
+type declaredInsights struct {
+	URL            string
+	URLField       string // where URL was declared, for messages
+	HeaderURL      string
+	Status         string
+	Funding        string
+	Administrators int
+	// Owners are the administrators and core team members, as declared
+	Owners []declaredContact
+}
+
+// declaredContact is a person listed in an insights file
+type declaredContact struct {
+	Name   string
+	Email  string
+	Social string
 }
 
-// checkLicense checks for LICENSE file
-func (c *Checker) checkLicense() FileCheck {
-	possiblePaths := []string{
-		filepath.Join(c.repoPath, "LICENSE"),
-		filepath.Join(c.repoPath, "LICENSE.md"),
-		filepath.Join(c.repoPath, "LICENSE.txt"),
-		filepath.Join(c.repoPath, "COPYING"),
+// readDeclaredInsights reads the repository URL, header URL, lifecycle
+// status and project funding details from an insights file, accepting both
+// the v2 and v1 field locations
+func readDeclaredInsights(path string) declaredInsights {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return declaredInsights{}
 	}
 
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); err == nil {
-			return FileCheck{
-				Name:   "LICENSE",
-				Path:   path,
-				Exists: true,
-				Valid:  true,
+	var insights struct {
+		Header struct {
+			URL        string `yaml:"url"`
+			ProjectURL string `yaml:"project-url"`
+		} `yaml:"header"`
+		ProjectLifecycle struct {
+			Status string `yaml:"status"`
+		} `yaml:"project-lifecycle"`
+		Project struct {
+			Funding        string        `yaml:"funding"`
+			Administrators []interface{} `yaml:"administrators"`
+		} `yaml:"project"`
+		Repository struct {
+			URL      string        `yaml:"url"`
+			Status   string        `yaml:"status"`
+			CoreTeam []interface{} `yaml:"core-team"`
+		} `yaml:"repository"`
+	}
+	if err := yaml.Unmarshal(data, &insights); err != nil {
+		return declaredInsights{}
+	}
+
+	declared := declaredInsights{
+		URL:       insights.Repository.URL,
+		URLField:  "repository.url",
+		HeaderURL: insights.Header.URL,
+		Status:    insights.Repository.Status,
+
+		Funding:        insights.Project.Funding,
+		Administrators: len(insights.Project.Administrators),
+		Owners:         declaredContacts(insights.Project.Administrators, insights.Repository.CoreTeam),
+	}
+	if declared.URL == "" {
+		declared.URL = insights.Header.ProjectURL
+		declared.URLField = "header.project-url"
+	}
+	if declared.Status == "" {
+		declared.Status = insights.ProjectLifecycle.Status
+	}
+	return declared
+}
+
+// declaredContacts collects the named entries of contact lists, skipping
+// repeats and anything that is not a contact mapping
+func declaredContacts(lists ...[]interface{}) []declaredContact {
+	var contacts []declaredContact
+	seen := make(map[declaredContact]bool)
+	for _, list := range lists {
+		for _, entry := range list {
+			fields, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			contact := declaredContact{}
+			contact.Name, _ = fields["name"].(string)
+			contact.Email, _ = fields["email"].(string)
+			contact.Social, _ = fields["social"].(string)
+			if contact.Name == "" || seen[contact] {
+				continue
 			}
+			seen[contact] = true
+			contacts = append(contacts, contact)
 		}
 	}
+	return contacts
+}
+
+// referencesSecurityPolicy reports whether a document links to SECURITY.md or
+// otherwise explains how to report security issues
+func referencesSecurityPolicy(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
 
-	return FileCheck{
-		Name:   "LICENSE",
-		Path:   "",
-		Exists: false,
-		Valid:  false,
+	content := strings.ToLower(string(data))
+	for _, marker := range []string{"security.md", "security policy", "vulnerabilit", "security issue"} {
+		if strings.Contains(content, marker) {
+			return true
+		}
 	}
+	return false
 }
 
-// checkCodeOfConduct checks for CODE_OF_CONDUCT.md file
-func (c *Checker) checkCodeOfConduct() FileCheck {
-	possiblePaths := []string{
-		filepath.Join(c.repoPath, "CODE_OF_CONDUCT.md"),
-		filepath.Join(c.repoPath, ".github", "CODE_OF_CONDUCT.md"),
-		filepath.Join(c.repoPath, "docs", "CODE_OF_CONDUCT.md"),
+// checkManifest compares generated files against the manifest written by
+// 'setup --manifest' and reports any that have changed since generation
+func (c *Checker) checkManifest(result *CheckResult) {
+	m, err := manifest.Load(c.repoPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecManifestUnreadable,
+				Priority:    "low",
+				Category:    "Provenance",
+				Description: fmt.Sprintf("%s could not be read: %v", manifest.FileName, err),
+				Action:      "Run 'baseline-init setup --manifest' to regenerate the manifest",
+				Reference:   baselineReference,
+				Effort:      "auto",
+			})
+		}
+		return
 	}
 
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); err == nil {
-			return FileCheck{
-				Name:   "CODE_OF_CONDUCT.md",
-				Path:   path,
-				Exists: true,
-				Valid:  true,
+	for _, drifted := range m.Drifted(c.repoPath) {
+		fileName := ""
+		for i := range result.Files {
+			if result.Files[i].Path == "" {
+				continue
+			}
+			if rel, err := filepath.Rel(c.repoPath, result.Files[i].Path); err == nil && filepath.ToSlash(rel) == drifted {
+				result.Files[i].Warnings = append(result.Files[i].Warnings,
+					"File has changed since it was generated")
+				fileName = result.Files[i].Name
 			}
 		}
+
+		result.Recommendations = append(result.Recommendations, Recommendation{
+			ID:          RecManifestDrift,
+			Priority:    "low",
+			Category:    "Provenance",
+			Description: fmt.Sprintf("%s differs from the version recorded in %s", drifted, manifest.FileName),
+			Action:      "Review the changes, then run 'baseline-init setup --manifest' to record them",
+			Reference:   baselineReference,
+			Effort:      "manual",
+			File:        fileName,
+		})
 	}
+}
 
-	return FileCheck{
-		Name:   "CODE_OF_CONDUCT.md",
-		Path:   "",
-		Exists: false,
-		Valid:  false,
+// checkFileModes warns about existing compliance files that are group or
+// world writable, since anyone sharing the machine could alter the security
+// contact or policy. Windows is skipped as it does not use Unix permissions.
+func (c *Checker) checkFileModes(result *CheckResult) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	for i := range result.Files {
+		file := &result.Files[i]
+		if !file.Exists {
+			continue
+		}
+
+		info, err := os.Stat(file.Path)
+		if err != nil || info.Mode().Perm()&0022 == 0 {
+			continue
+		}
+
+		file.Warnings = append(file.Warnings,
+			fmt.Sprintf("File mode %s allows group or world writes", info.Mode().Perm()))
+		result.Recommendations = append(result.Recommendations, Recommendation{
+			ID:          RecFileWritable,
+			Priority:    "medium",
+			Category:    "File Permissions",
+			Description: fmt.Sprintf("%s is writable by other users", file.Name),
+			Action:      fmt.Sprintf("Run 'chmod go-w %s'", file.Path),
+			Reference:   baselineReference,
+			Effort:      "manual",
+			File:        file.Name,
+		})
 	}
 }
 
-// checkContributing checks for CONTRIBUTING.md file
-func (c *Checker) checkContributing() FileCheck {
-	possiblePaths := []string{
-		filepath.Join(c.repoPath, "CONTRIBUTING.md"),
-		filepath.Join(c.repoPath, ".github", "CONTRIBUTING.md"),
-		filepath.Join(c.repoPath, "docs", "CONTRIBUTING.md"),
+// checkTracking warns about compliance files that git does not track, since
+// they exist on disk but are not seen by anyone else. Outside a git work
+// tree the check is skipped.
+func (c *Checker) checkTracking(result *CheckResult) {
+	for i := range result.Files {
+		file := &result.Files[i]
+		if !file.Exists {
+			continue
+		}
+
+		relPath, err := filepath.Rel(c.repoPath, file.Path)
+		if err != nil {
+			relPath = file.Path
+		}
+
+		state, err := gitutil.TrackingState(c.repoPath, relPath)
+		if err != nil {
+			return
+		}
+
+		var action string
+		switch state {
+		case gitutil.Untracked:
+			file.Warnings = append(file.Warnings, "Not tracked by git, so it will not ship with the repository")
+			action = fmt.Sprintf("Run 'git add %s' and commit it", relPath)
+		case gitutil.Ignored:
+			file.Warnings = append(file.Warnings, "Ignored by git, so it will not ship with the repository")
+			action = fmt.Sprintf("Remove the .gitignore rule matching %s, then add and commit it", relPath)
+		default:
+			continue
+		}
+
+		result.Recommendations = append(result.Recommendations, Recommendation{
+			ID:          RecFileUntracked,
+			Priority:    "medium",
+			Category:    "Repository Hygiene",
+			Description: fmt.Sprintf("%s is not committed to the repository", file.Name),
+			Action:      action,
+			Reference:   baselineReference,
+			Effort:      "manual",
+			File:        file.Name,
+		})
 	}
+}
 
-	for _, path := range possiblePaths {
+// checkDuplicateInsights reports when SECURITY-INSIGHTS.yml exists in more
+// than one location. Only the first is used, so the others are usually stale
+// copies; differing schema versions make that near certain.
+func (c *Checker) checkDuplicateInsights(siCheck *FileCheck, result *CheckResult) {
+	var found []string
+	for _, path := range c.insightsPaths() {
 		if _, err := os.Stat(path); err == nil {
-			return FileCheck{
-				Name:   "CONTRIBUTING.md",
-				Path:   path,
-				Exists: true,
-				Valid:  true,
+			found = append(found, path)
+		}
+	}
+	if len(found) < 2 {
+		return
+	}
+
+	versions := make([]string, len(found))
+	conflicting := false
+	for i, path := range found {
+		versions[i] = readSchemaVersion(path)
+		if versions[i] != versions[0] {
+			conflicting = true
+		}
+	}
+
+	if conflicting {
+		described := make([]string, len(found))
+		for i, path := range found {
+			version := versions[i]
+			if version == "" {
+				version = "no version"
 			}
+			described[i] = fmt.Sprintf("%s (%s)", path, version)
 		}
+		siCheck.Warnings = append(siCheck.Warnings,
+			fmt.Sprintf("Copies declare different schema versions: %s", strings.Join(described, ", ")))
+	} else {
+		siCheck.Warnings = append(siCheck.Warnings,
+			fmt.Sprintf("Found in %d locations: %s", len(found), strings.Join(found, ", ")))
+	}
+
+	priority := "low"
+	if conflicting {
+		priority = "medium"
+	}
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsDuplicate,
+		Priority:    priority,
+		Category:    "Security Metadata",
+		Description: "Multiple SECURITY-INSIGHTS.yml files found",
+		Action:      fmt.Sprintf("Keep %s and remove the other copies", found[0]),
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        siCheck.Name,
+	})
+}
+
+// checkSecrets flags credentials pasted into free-text fields of a file
+// that will be published with the repository
+func checkSecrets(fileCheck *FileCheck, result *CheckResult) {
+	data, err := os.ReadFile(fileCheck.Path)
+	if err != nil {
+		return
+	}
+
+	findings := validator.FindSecrets(data)
+	if len(findings) == 0 {
+		return
 	}
 
-	return FileCheck{
-		Name:   "CONTRIBUTING.md",
-		Path:   "",
-		Exists: false,
-		Valid:  false,
+	fields := make([]string, len(findings))
+	for i, finding := range findings {
+		fileCheck.Warnings = append(fileCheck.Warnings, fmt.Sprintf("Possible secret: %s", finding))
+		fields[i] = finding.Field
 	}
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecInsightsSecret,
+		Priority:    "high",
+		Category:    "Security Metadata",
+		Description: fmt.Sprintf("%s may contain a secret", fileCheck.Name),
+		Action:      fmt.Sprintf("Remove the value from %s and rotate the credential", strings.Join(fields, ", ")),
+		Reference:   insightsReference,
+		Effort:      "manual",
+		File:        fileCheck.Name,
+	})
+}
+
+// readSchemaVersion returns header.schema-version from an insights file, or
+// an empty string if it cannot be read
+func readSchemaVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	var insights struct {
+		Header struct {
+			SchemaVersion string `yaml:"schema-version"`
+		} `yaml:"header"`
+	}
+	if err := yaml.Unmarshal(data, &insights); err != nil {
+		return ""
+	}
+	return insights.Header.SchemaVersion
+}
+
+// insightsPaths lists the locations where SECURITY-INSIGHTS.yml is looked
+// for, in order of preference
+func (c *Checker) insightsPaths() []string {
+	return c.requiredPaths("SECURITY-INSIGHTS.yml")
+}
+
+// insightsValid reports whether SECURITY-INSIGHTS.yml, if among the checked
+// files, is valid. Levels that do not require it leave it out of the files.
+func insightsValid(files []FileCheck) bool {
+	for _, file := range files {
+		if file.Name == "SECURITY-INSIGHTS.yml" {
+			return file.Valid
+		}
+	}
+	return true
+}
+
+// review runs the checks specific to a file that was found, adding to its
+// warnings and to the result's recommendations. Findings that the file falls
+// short take its priority from def, as a config may have tailored it; lesser
+// advisories keep their own. The insights declared in SECURITY-INSIGHTS.yml
+// are read into declared for the checks after it.
+func (c *Checker) review(ctx context.Context, def CheckDefinition, file *FileCheck, declared *declaredInsights, result *CheckResult) {
+	switch def.ID {
+	case "security-insights":
+		*declared = readDeclaredInsights(file.Path)
+		c.checkRemoteURL(file, *declared, result)
+		c.checkHeaderURL(file, *declared, result)
+		c.checkLifecycleStatus(file, *declared, result)
+		c.checkArchivalStatus(ctx, file, *declared, result)
+		if !c.skipped["funding"] {
+			c.checkFunding(file, *declared, result)
+		}
+		c.checkOwnership(file, *declared, result)
+		c.checkDuplicateInsights(file, result)
+		if !c.skipped["secrets"] {
+			checkSecrets(file, result)
+		}
+		if !file.Valid {
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecInsightsInvalid,
+				Priority:    def.Priority,
+				Category:    "Security Metadata",
+				Description: "SECURITY-INSIGHTS.yml is not valid",
+				Action:      fmt.Sprintf("Run 'baseline-init validate %s' and fix the reported errors", file.Path),
+				Reference:   insightsReference,
+				Effort:      "manual",
+				File:        "SECURITY-INSIGHTS.yml",
+			})
+		}
+
+	case "security-policy":
+		if len(file.Warnings) > 0 {
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecPolicyIncomplete,
+				Priority:    def.Priority,
+				Category:    "Security Policy",
+				Description: "SECURITY.md does not fully explain how to report a vulnerability",
+				Action:      "Add a \"Reporting a Vulnerability\" section with a contact address and the expected response time",
+				Reference:   baselineReference,
+				Effort:      "manual",
+				File:        "SECURITY.md",
+			})
+		}
+		c.checkPrivateReporting(ctx, file, *declared, result)
+
+	case "license":
+		if file.Detected == "" {
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecLicenseUnrecognized,
+				Priority:    "low",
+				Category:    "Legal",
+				Description: "LICENSE is not a recognized open source license",
+				Action:      "Use the unmodified text of a standard license such as Apache-2.0 or MIT so tools and reviewers can identify it",
+				Reference:   baselineReference,
+				Effort:      "policy",
+				File:        "LICENSE",
+			})
+		}
+
+	case "contributing":
+		if !referencesSecurityPolicy(file.Path) {
+			file.Warnings = append(file.Warnings,
+				"Does not mention how to report security issues or link to SECURITY.md")
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecContributingNoPolicy,
+				Priority:    def.Priority,
+				Category:    "Community",
+				Description: "CONTRIBUTING.md does not reference the security policy",
+				Action:      "Link to SECURITY.md so contributors report vulnerabilities privately",
+				Reference:   baselineReference,
+				Effort:      "manual",
+				File:        "CONTRIBUTING.md",
+			})
+		}
+
+	case "governance":
+		if len(file.Warnings) > 0 || !file.Valid {
+			result.Recommendations = append(result.Recommendations, Recommendation{
+				ID:          RecGovernanceIncomplete,
+				Priority:    def.Priority,
+				Category:    "Governance",
+				Description: "GOVERNANCE.md does not cover all key governance topics",
+				Action:      "Document roles, the decision-making process, and how to become a maintainer",
+				Reference:   baselineReference,
+				Effort:      "manual",
+				File:        "GOVERNANCE.md",
+			})
+		}
+
+	case "codeowners":
+		checkCodeOwners(file, result)
+	}
+}
+
+// checkCodeOwners warns when CODEOWNERS assigns no owners, as when it holds
+// only comments, since it then requests no reviews
+func checkCodeOwners(file *FileCheck, result *CheckResult) {
+	data, err := os.ReadFile(file.Path)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) > 1 {
+			return
+		}
+	}
+
+	file.Warnings = append(file.Warnings, "No path is assigned an owner")
+	result.Recommendations = append(result.Recommendations, Recommendation{
+		ID:          RecCodeOwnersEmpty,
+		Priority:    "low",
+		Category:    "Governance",
+		Description: "CODEOWNERS does not assign any owners",
+		Action:      "Add a rule such as '* @maintainer' so changes are reviewed by their owners",
+		Reference:   codeOwnersReference,
+		Effort:      "manual",
+		File:        "CODEOWNERS",
+	})
 }
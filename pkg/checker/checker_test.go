@@ -4,11 +4,34 @@
 package checker
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/config"
+	"github.com/aguamala/baseline-init/pkg/github"
+	"github.com/aguamala/baseline-init/pkg/license"
+	"github.com/aguamala/baseline-init/pkg/manifest"
+	"github.com/aguamala/baseline-init/pkg/validator"
 )
 
+// validInsights is a minimal SECURITY-INSIGHTS.yml that passes validation
+const validInsights = `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`
+
 func TestChecker_Check(t *testing.T) {
 	// Create temporary test directory
 	tmpDir, err := os.MkdirTemp("", "baseline-test-*")
@@ -22,6 +45,7 @@ func TestChecker_Check(t *testing.T) {
 		setupFiles      map[string]string
 		wantCompliant   bool
 		wantMissingLen  int
+		wantInvalid     bool
 	}{
 		{
 			name:           "empty repository",
@@ -32,7 +56,7 @@ func TestChecker_Check(t *testing.T) {
 		{
 			name: "only SECURITY-INSIGHTS.yml",
 			setupFiles: map[string]string{
-				"SECURITY-INSIGHTS.yml": "test content",
+				"SECURITY-INSIGHTS.yml": validInsights,
 			},
 			wantCompliant:  false,
 			wantMissingLen: 2, // SECURITY.md, LICENSE
@@ -40,17 +64,28 @@ func TestChecker_Check(t *testing.T) {
 		{
 			name: "all required files",
 			setupFiles: map[string]string{
-				"SECURITY-INSIGHTS.yml": "test content",
+				"SECURITY-INSIGHTS.yml": validInsights,
 				"SECURITY.md":           "security policy",
 				"LICENSE":               "license content",
 			},
 			wantCompliant:  true,
 			wantMissingLen: 0,
 		},
+		{
+			name: "invalid SECURITY-INSIGHTS.yml",
+			setupFiles: map[string]string{
+				"SECURITY-INSIGHTS.yml": "header: [unclosed",
+				"SECURITY.md":           "security policy",
+				"LICENSE":               "license content",
+			},
+			wantCompliant:  false,
+			wantMissingLen: 0,
+			wantInvalid:    true,
+		},
 		{
 			name: "SECURITY-INSIGHTS.yml in .github",
 			setupFiles: map[string]string{
-				".github/SECURITY-INSIGHTS.yml": "test content",
+				".github/SECURITY-INSIGHTS.yml": validInsights,
 				"SECURITY.md":                   "security policy",
 				"LICENSE":                       "license content",
 			},
@@ -94,10 +129,122 @@ func TestChecker_Check(t *testing.T) {
 				t.Errorf("MissingFiles length = %d, want %d (missing: %v)",
 					len(result.MissingFiles), tt.wantMissingLen, result.MissingFiles)
 			}
+
+			si := result.Files[0]
+			if si.Exists && si.Valid == tt.wantInvalid {
+				t.Errorf("SECURITY-INSIGHTS.yml Valid = %v, want %v (errors: %v)", si.Valid, !tt.wantInvalid, si.Errors)
+			}
+			if tt.wantInvalid {
+				if len(si.Errors) == 0 {
+					t.Errorf("expected validation errors on %s", si.Name)
+				}
+				found := false
+				for _, rec := range result.Recommendations {
+					if rec.Description == "SECURITY-INSIGHTS.yml is not valid" {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected a recommendation for the invalid file")
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_Score(t *testing.T) {
+	mit, _ := license.Text("MIT")
+	required := map[string]string{
+		"SECURITY-INSIGHTS.yml": validInsights,
+		"SECURITY.md":           "# Security\n",
+		"LICENSE":               mit,
+	}
+	all := map[string]string{
+		"CODE_OF_CONDUCT.md":              "# Code of Conduct\n",
+		"CONTRIBUTING.md":                 "# Contributing\n\nReport vulnerabilities as SECURITY.md describes.\n",
+		"GOVERNANCE.md":                   "# Governance\n",
+		"CODEOWNERS":                      "* @maintainer\n",
+		"renovate.json":                   "{}\n",
+		".github/workflows/scorecard.yml": "jobs:\n  analysis:\n    steps:\n      - uses: ossf/scorecard-action@v2\n",
+	}
+	for name, content := range required {
+		all[name] = content
+	}
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		wantScore  int
+		wantEarned int
+	}{
+		{name: "empty repository", files: map[string]string{}, wantScore: 0, wantEarned: 0},
+		// high 3 + medium 2 + high 3 of the 17 available
+		{name: "required files only", files: required, wantScore: 47, wantEarned: 8},
+		{name: "all files", files: all, wantScore: 100, wantEarned: 17},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(testDir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write file: %v", err)
+				}
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if result.Score != tt.wantScore || result.EarnedScore != tt.wantEarned || result.MaxScore != 17 {
+				t.Errorf("score = %d (%d/%d), want %d (%d/17)",
+					result.Score, result.EarnedScore, result.MaxScore, tt.wantScore, tt.wantEarned)
+			}
 		})
 	}
 }
 
+func TestCheckResult_PromoteWarnings(t *testing.T) {
+	mit, _ := license.Text("MIT")
+	testDir := t.TempDir()
+	for name, content := range map[string]string{
+		"LICENSE": mit,
+		// Lacks the expected sections, so it is valid with warnings
+		"GOVERNANCE.md": "# Governance\n",
+	} {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	result, err := New(testDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if status := result.Checks["governance-valid"].Status; status != StatusWarn {
+		t.Fatalf("governance-valid = %s before promotion, want %s", status, StatusWarn)
+	}
+	before := result.EarnedScore
+
+	if !result.PromoteWarnings() {
+		t.Fatal("PromoteWarnings() = false, want true")
+	}
+	if outcome := result.Checks["governance-valid"]; outcome.Status != StatusFail || outcome.Severity != "low" {
+		t.Errorf("governance-valid = %+v after promotion, want a low-severity fail", outcome)
+	}
+	// GOVERNANCE.md, worth the low weight of 1, is no longer earned
+	if result.EarnedScore != before-1 || result.Score != result.EarnedScore*100/result.MaxScore {
+		t.Errorf("score = %d (%d/%d) after promotion, want %d earned", result.Score, result.EarnedScore, result.MaxScore, before-1)
+	}
+	if status := result.Checks["license-valid"].Status; status != StatusPass {
+		t.Errorf("license-valid = %s after promotion, want %s", status, StatusPass)
+	}
+}
+
 func TestChecker_CheckSecurityInsights(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "baseline-test-*")
 	if err != nil {
@@ -151,7 +298,7 @@ func TestChecker_CheckSecurityInsights(t *testing.T) {
 			}
 
 			c := New(testDir)
-			result := c.checkSecurityInsights()
+			result := c.inspect(Checks[0])
 
 			if result.Exists != tt.wantExists {
 				t.Errorf("Exists = %v, want %v", result.Exists, tt.wantExists)
@@ -159,3 +306,1532 @@ func TestChecker_CheckSecurityInsights(t *testing.T) {
 		})
 	}
 }
+
+func TestChecker_ShallowClone(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	// last-reviewed predates the first commit, which only the full history
+	// can tell
+	insights := `header:
+  schema-version: 2.0.0
+  last-updated: '2020-01-01'
+  last-reviewed: '2020-01-01'
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`
+	origin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(origin, "SECURITY-INSIGHTS.yml"), []byte(insights), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	clone := t.TempDir()
+	commit := []string{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "change"}
+	for _, step := range []struct {
+		dir  string
+		args []string
+	}{
+		{origin, []string{"init", "-q"}},
+		{origin, []string{"add", "SECURITY-INSIGHTS.yml"}},
+		{origin, commit},
+		{origin, commit},
+		{clone, []string{"clone", "-q", "--depth", "1", "file://" + origin, "."}},
+	} {
+		cmd := exec.Command("git", step.args...)
+		cmd.Dir = step.dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE=2024-06-01T12:00:00Z", "GIT_COMMITTER_DATE=2024-06-01T12:00:00Z")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v (%s)", step.args, err, out)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dir       string
+		wantValid bool
+	}{
+		{"full clone", origin, false},
+		{"shallow clone skips history rules", clone, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New(tt.dir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if si := result.Files[0]; si.Valid != tt.wantValid {
+				t.Errorf("SECURITY-INSIGHTS.yml Valid = %v, want %v (errors: %v)", si.Valid, tt.wantValid, si.Errors)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckManifest(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "baseline-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	siPath := filepath.Join(tmpDir, "SECURITY-INSIGHTS.yml")
+	if err := os.WriteFile(siPath, []byte("original"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	m := manifest.New(nil)
+	if err := m.AddFile(tmpDir, siPath); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+	if err := m.Write(tmpDir); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	result, err := New(tmpDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, rec := range result.Recommendations {
+		if rec.Category == "Provenance" {
+			t.Errorf("unexpected drift recommendation for unchanged file: %s", rec.Description)
+		}
+	}
+
+	if err := os.WriteFile(siPath, []byte("edited"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	result, err = New(tmpDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	found := false
+	for _, rec := range result.Recommendations {
+		if rec.Category == "Provenance" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected drift recommendation after editing generated file")
+	}
+	if len(checkerWarnings(t, result.Files[0])) == 0 {
+		t.Errorf("expected drift warning on %s", result.Files[0].Name)
+	}
+}
+
+func TestChecker_CheckRemoteURL(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tests := []struct {
+		name        string
+		remote      string
+		declared    string
+		headerURL   string
+		v1          bool
+		wantWarning bool
+		wantField   string // named by the medium-priority mismatch recommendation
+	}{
+		{
+			name:        "matching remote",
+			remote:      "git@github.com:example/repo.git",
+			declared:    "https://github.com/example/repo",
+			wantWarning: false,
+		},
+		{
+			name:        "fork with upstream metadata",
+			remote:      "https://github.com/someone/repo.git",
+			declared:    "https://github.com/example/repo",
+			wantWarning: true,
+			wantField:   "repository.url",
+		},
+		{
+			name:        "moved repository with 1.0.0 project-url",
+			remote:      "git@github.com:new-owner/repo.git",
+			declared:    "https://github.com/example/repo",
+			v1:          true,
+			wantWarning: true,
+			wantField:   "header.project-url",
+		},
+		{
+			name:        "no remote",
+			declared:    "https://github.com/example/repo",
+			wantWarning: false,
+		},
+		{
+			name:        "header url is a marketing homepage",
+			remote:      "https://github.com/example/repo.git",
+			declared:    "https://github.com/example/repo",
+			headerURL:   "https://www.example.com/product",
+			wantWarning: true,
+		},
+		{
+			name:        "header url is a page within the repository",
+			remote:      "https://github.com/example/repo.git",
+			declared:    "https://github.com/example/repo",
+			headerURL:   "https://github.com/example/repo/tree/main",
+			wantWarning: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+
+			commands := [][]string{{"init", "-q"}}
+			if tt.remote != "" {
+				commands = append(commands, []string{"remote", "add", "origin", tt.remote})
+			}
+			for _, args := range commands {
+				cmd := exec.Command("git", args...)
+				cmd.Dir = testDir
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("git %v failed: %v (%s)", args, err, out)
+				}
+			}
+
+			content := "header:\n  schema-version: 2.0.0\n"
+			if tt.headerURL != "" {
+				content += "  url: " + tt.headerURL + "\n"
+			}
+			content += "repository:\n  url: " + tt.declared + "\n"
+			if tt.v1 {
+				content = "header:\n  schema-version: 1.0.0\n  project-url: " + tt.declared + "\n"
+			}
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			// Stage the file so it is not also reported as untracked
+			add := exec.Command("git", "add", "SECURITY-INSIGHTS.yml")
+			add.Dir = testDir
+			if out, err := add.CombinedOutput(); err != nil {
+				t.Fatalf("git add failed: %v (%s)", err, out)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[0])
+			gotWarning := len(warnings) > 0
+			if gotWarning != tt.wantWarning {
+				t.Errorf("remote mismatch warning = %v, want %v (warnings: %v)",
+					gotWarning, tt.wantWarning, warnings)
+			}
+
+			if tt.wantField != "" {
+				want := "SECURITY-INSIGHTS.yml " + tt.wantField + " does not match the git remote"
+				found := false
+				for _, rec := range result.Recommendations {
+					if rec.Description == want && rec.Priority == "medium" {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected medium recommendation %q, got %+v", want, result.Recommendations)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_CheckLifecycleStatus(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tests := []struct {
+		name        string
+		status      string
+		commitAge   time.Duration
+		wantWarning bool
+	}{
+		{"active with recent commit", "active", 24 * time.Hour, false},
+		{"active but stale", "active", 2 * 365 * 24 * time.Hour, true},
+		{"archived and quiet", "archived", 2 * 365 * 24 * time.Hour, false},
+		{"archived but recently changed", "archived", 24 * time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+
+			content := "header:\n  schema-version: 2.0.0\nrepository:\n  status: " + tt.status + "\n"
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			date := time.Now().Add(-tt.commitAge).Format(time.RFC3339)
+			for _, args := range [][]string{
+				{"init", "-q"},
+				{"add", "."},
+				{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init"},
+			} {
+				cmd := exec.Command("git", args...)
+				cmd.Dir = testDir
+				cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("git %v failed: %v (%s)", args, err, out)
+				}
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[0])
+			gotWarning := len(warnings) > 0
+			if gotWarning != tt.wantWarning {
+				t.Errorf("status warning = %v, want %v (warnings: %v)",
+					gotWarning, tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestChecker_ContributingReferencesSecurityPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantWarning bool
+	}{
+		{"links SECURITY.md", "# Contributing\n\nSee [SECURITY.md](SECURITY.md) to report vulnerabilities.\n", false},
+		{"mentions vulnerabilities", "# Contributing\n\nPlease report vulnerabilities privately.\n", false},
+		{"no security mention", "# Contributing\n\nFork, branch, open a pull request.\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(testDir, "CONTRIBUTING.md"), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			var contributing FileCheck
+			for _, f := range result.Files {
+				if f.Name == "CONTRIBUTING.md" {
+					contributing = f
+				}
+			}
+
+			gotWarning := len(contributing.Warnings) > 0
+			if gotWarning != tt.wantWarning {
+				t.Errorf("security reference warning = %v, want %v (warnings: %v)",
+					gotWarning, tt.wantWarning, contributing.Warnings)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckArchivalStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		marker      bool
+		apiArchived *bool
+		wantWarning bool
+	}{
+		{name: "active without any signal", status: "active", wantWarning: false},
+		{name: "archived without any signal", status: "archived", wantWarning: false},
+		{name: "active with archive marker", status: "active", marker: true, wantWarning: true},
+		{name: "archived with archive marker", status: "archived", marker: true, wantWarning: false},
+		{name: "active on archived GitHub repo", status: "active", apiArchived: boolPtr(true), wantWarning: true},
+		{name: "archived on active GitHub repo", status: "archived", apiArchived: boolPtr(false), wantWarning: true},
+		{name: "active on active GitHub repo", status: "active", apiArchived: boolPtr(false), wantWarning: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+
+			content := "header:\n  schema-version: 2.0.0\nrepository:\n  url: https://github.com/example/repo\n  status: " + tt.status + "\n"
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+			if tt.marker {
+				if err := os.WriteFile(filepath.Join(testDir, ".archived"), nil, 0644); err != nil {
+					t.Fatalf("Failed to write marker: %v", err)
+				}
+			}
+
+			c := New(testDir)
+			if tt.apiArchived != nil {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/repos/example/repo" {
+						http.NotFound(w, r)
+						return
+					}
+					fmt.Fprintf(w, `{"full_name": "example/repo", "archived": %t}`, *tt.apiArchived)
+				}))
+				defer server.Close()
+
+				client := github.NewClient()
+				client.SetBaseURL(server.URL)
+				c.SetGitHubClient(client)
+			}
+
+			result, err := c.Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[0])
+			gotWarning := len(warnings) > 0
+			if gotWarning != tt.wantWarning {
+				t.Errorf("archival warning = %v, want %v (warnings: %v)",
+					gotWarning, tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// checkerWarnings returns the warnings the checker added to file, leaving out
+// those that come from validating its contents
+func checkerWarnings(t *testing.T, file FileCheck) []string {
+	t.Helper()
+
+	validated := map[string]bool{}
+	if result, err := validator.New().ValidateFile(file.Path); err == nil {
+		for _, warning := range result.Warnings {
+			validated[warning] = true
+		}
+	}
+
+	var warnings []string
+	for _, warning := range file.Warnings {
+		if !validated[warning] {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+func TestChecker_SelectRepositoryChecks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not checked on Windows")
+	}
+
+	testDir := t.TempDir()
+	path := filepath.Join(testDir, "SECURITY.md")
+	if err := os.WriteFile(path, []byte("# Security Policy\n"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Chmod(path, 0666); err != nil {
+		t.Fatalf("Failed to chmod file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		only     []string
+		skip     []string
+		wantMode bool
+	}{
+		{name: "all checks", wantMode: true},
+		{name: "skip file-modes", skip: []string{"file-modes"}, wantMode: false},
+		{name: "only a file check", only: []string{"security-policy"}, wantMode: false},
+		{name: "only the file and file-modes", only: []string{"security-policy", "file-modes"}, wantMode: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(testDir)
+			if err := c.SelectChecks(tt.only, tt.skip); err != nil {
+				t.Fatalf("SelectChecks() error = %v", err)
+			}
+			result, err := c.Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if got := hasRecommendation(result, RecFileWritable); got != tt.wantMode {
+				t.Errorf("file mode recommendation = %v, want %v", got, tt.wantMode)
+			}
+		})
+	}
+
+	if err := New(testDir).SelectChecks(nil, []string{"modes"}); err == nil {
+		t.Error("SelectChecks() accepted an unknown repository check ID")
+	}
+}
+
+func TestChecker_CheckFileModes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not checked on Windows")
+	}
+
+	tests := []struct {
+		name        string
+		mode        os.FileMode
+		wantWarning bool
+	}{
+		{"owner writable", 0644, false},
+		{"read only", 0444, false},
+		{"group writable", 0664, true},
+		{"world writable", 0666, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			path := filepath.Join(testDir, "SECURITY.md")
+			if err := os.WriteFile(path, []byte("# Security Policy\n"), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+			// Chmod explicitly so the umask does not affect the mode under test
+			if err := os.Chmod(path, tt.mode); err != nil {
+				t.Fatalf("Failed to chmod file: %v", err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[1])
+			gotWarning := len(warnings) > 0
+			if gotWarning != tt.wantWarning {
+				t.Errorf("file mode warning = %v, want %v (warnings: %v)",
+					gotWarning, tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckFunding(t *testing.T) {
+	admins := func(n int) string {
+		content := "  administrators:\n"
+		for i := 0; i < n; i++ {
+			content += fmt.Sprintf("    - name: admin%d\n", i)
+		}
+		return content
+	}
+
+	tests := []struct {
+		name        string
+		status      string
+		admins      int
+		funding     string
+		fundingFile string
+		threshold   int
+		want        bool
+	}{
+		{name: "small project", status: "active", admins: 1, threshold: 3, want: false},
+		{name: "substantial project without funding", status: "active", admins: 3, threshold: 3, want: true},
+		{name: "declared funding", status: "active", admins: 3, funding: "https://opencollective.com/example", threshold: 3, want: false},
+		{name: "funding file", status: "active", admins: 3, fundingFile: ".github/FUNDING.yml", threshold: 3, want: false},
+		{name: "archived project", status: "archived", admins: 5, threshold: 3, want: false},
+		{name: "lower threshold", status: "active", admins: 2, threshold: 2, want: true},
+		{name: "disabled", status: "active", admins: 5, threshold: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+
+			content := "header:\n  schema-version: 2.0.0\nproject:\n  name: example\n" + admins(tt.admins)
+			if tt.funding != "" {
+				content += "  funding: " + tt.funding + "\n"
+			}
+			content += "repository:\n  status: " + tt.status + "\n"
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+			if tt.fundingFile != "" {
+				path := filepath.Join(testDir, tt.fundingFile)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("Failed to create directory: %v", err)
+				}
+				if err := os.WriteFile(path, []byte("github: [example]\n"), 0644); err != nil {
+					t.Fatalf("Failed to write file: %v", err)
+				}
+			}
+
+			c := New(testDir)
+			c.SetFundingAdminThreshold(tt.threshold)
+			result, err := c.Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			got := false
+			for _, rec := range result.Recommendations {
+				if rec.Category == "Sustainability" {
+					got = true
+				}
+			}
+			if got != tt.want {
+				t.Errorf("funding recommendation = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckTracking(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tests := []struct {
+		name        string
+		gitRepo     bool
+		stage       bool
+		gitignore   string
+		wantWarning string
+	}{
+		{name: "tracked", gitRepo: true, stage: true},
+		{name: "untracked", gitRepo: true, wantWarning: "Not tracked"},
+		{name: "ignored", gitRepo: true, gitignore: "SECURITY.md\n", wantWarning: "Ignored"},
+		{name: "outside git", gitRepo: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY.md"), []byte("# Security Policy\n"), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+			if tt.gitignore != "" {
+				if err := os.WriteFile(filepath.Join(testDir, ".gitignore"), []byte(tt.gitignore), 0644); err != nil {
+					t.Fatalf("Failed to write .gitignore: %v", err)
+				}
+			}
+
+			var gitArgs [][]string
+			if tt.gitRepo {
+				gitArgs = append(gitArgs, []string{"init", "-q"})
+			}
+			if tt.stage {
+				gitArgs = append(gitArgs, []string{"add", "SECURITY.md"})
+			}
+			for _, args := range gitArgs {
+				cmd := exec.Command("git", args...)
+				cmd.Dir = testDir
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("git %v failed: %v (%s)", args, err, out)
+				}
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[1])
+			if tt.wantWarning == "" {
+				if len(warnings) > 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.HasPrefix(warnings[0], tt.wantWarning) {
+				t.Errorf("warnings = %v, want one starting with %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckGovernance(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantExists  bool
+		wantWarning bool
+	}{
+		{name: "missing", wantExists: false},
+		{name: "complete", content: "# Governance\n## Roles\n## Decision Making\n## Becoming a Maintainer\n", wantExists: true},
+		{name: "incomplete", content: "# Governance\n## Roles\n", wantExists: true, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			if tt.content != "" {
+				if err := os.WriteFile(filepath.Join(testDir, "GOVERNANCE.md"), []byte(tt.content), 0644); err != nil {
+					t.Fatalf("Failed to write file: %v", err)
+				}
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			var governance FileCheck
+			for _, f := range result.Files {
+				if f.Name == "GOVERNANCE.md" {
+					governance = f
+				}
+			}
+
+			if governance.Exists != tt.wantExists {
+				t.Errorf("Exists = %v, want %v", governance.Exists, tt.wantExists)
+			}
+			if gotWarning := len(governance.Warnings) > 0; gotWarning != tt.wantWarning {
+				t.Errorf("section warnings = %v, want %v (%v)", gotWarning, tt.wantWarning, governance.Warnings)
+			}
+			for _, missing := range result.MissingFiles {
+				if missing == "GOVERNANCE.md" {
+					t.Error("GOVERNANCE.md is optional and should not be listed as missing")
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_CheckDuplicateInsights(t *testing.T) {
+	tests := []struct {
+		name         string
+		rootVersion  string
+		githubCopy   string
+		wantWarning  string
+		wantPriority string
+	}{
+		{name: "single file", rootVersion: "2.0.0"},
+		{name: "identical versions", rootVersion: "2.0.0", githubCopy: "2.0.0", wantWarning: "Found in 2 locations", wantPriority: "low"},
+		{name: "conflicting versions", rootVersion: "2.0.0", githubCopy: "1.0.0", wantWarning: "Copies declare different schema versions", wantPriority: "medium"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			files := map[string]string{
+				"SECURITY-INSIGHTS.yml": "header:\n  schema-version: " + tt.rootVersion + "\n",
+			}
+			if tt.githubCopy != "" {
+				files[filepath.Join(".github", "SECURITY-INSIGHTS.yml")] = "header:\n  schema-version: " + tt.githubCopy + "\n"
+			}
+			for name, content := range files {
+				path := filepath.Join(testDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("Failed to create directory: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write file: %v", err)
+				}
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			warnings := checkerWarnings(t, result.Files[0])
+			if tt.wantWarning == "" {
+				if len(warnings) > 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+
+			if len(warnings) != 1 || !strings.HasPrefix(warnings[0], tt.wantWarning) {
+				t.Fatalf("warnings = %v, want one starting with %q", warnings, tt.wantWarning)
+			}
+			if tt.githubCopy != tt.rootVersion && !strings.Contains(warnings[0], tt.githubCopy) {
+				t.Errorf("warning %q does not name version %s", warnings[0], tt.githubCopy)
+			}
+
+			for _, rec := range result.Recommendations {
+				if rec.Description == "Multiple SECURITY-INSIGHTS.yml files found" && rec.Priority != tt.wantPriority {
+					t.Errorf("recommendation priority = %s, want %s", rec.Priority, tt.wantPriority)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_CheckContextCancelled(t *testing.T) {
+	testDir := t.TempDir()
+	for _, name := range []string{"SECURITY-INSIGHTS.yml", "SECURITY.md", "LICENSE", "CODE_OF_CONDUCT.md"} {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte("content\n"), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	complete, err := New(testDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if complete.Partial {
+		t.Error("Check() without a deadline reported partial results")
+	}
+	if complete.ReportVersion != ReportVersion {
+		t.Errorf("ReportVersion = %q, want %q", complete.ReportVersion, ReportVersion)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := New(testDir).CheckContext(ctx)
+	if err != nil {
+		t.Fatalf("CheckContext() error = %v", err)
+	}
+	if !result.Partial {
+		t.Error("Partial = false, want true for a cancelled context")
+	}
+	if result.IsCompliant {
+		t.Error("IsCompliant = true, want false for partial results")
+	}
+	if len(result.Files) >= len(complete.Files) {
+		t.Errorf("checked %d files, want fewer than the %d of a complete check", len(result.Files), len(complete.Files))
+	}
+}
+
+func TestChecker_AutoFixable(t *testing.T) {
+	result, err := New(t.TempDir()).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	// setup --auto generates exactly these files; everything else needs a person
+	want := map[string]bool{
+		"SECURITY-INSIGHTS.yml": true,
+		"SECURITY.md":           true,
+		"GOVERNANCE.md":         true,
+		"CODE_OF_CONDUCT.md":    true,
+		"CONTRIBUTING.md":       true,
+		"CODEOWNERS":            true,
+		"dependabot.yml":        true,
+	}
+	for _, rec := range result.Recommendations {
+		if rec.AutoFixable != want[rec.File] {
+			t.Errorf("%q: AutoFixable = %v, want %v", rec.Description, rec.AutoFixable, want[rec.File])
+		}
+		if rec.AutoFixable != (rec.Effort == "auto") {
+			t.Errorf("%q: AutoFixable = %v with effort %q", rec.Description, rec.AutoFixable, rec.Effort)
+		}
+	}
+}
+
+func TestChecker_RecommendationIDs(t *testing.T) {
+	// One repository with every file missing, and one where each file is
+	// present but flawed, between them triggering most recommendations
+	flawed := t.TempDir()
+	files := map[string]string{
+		"SECURITY-INSIGHTS.yml": "header:\n  schema-version: 2.0.0\n  comment: 'token ghp_" + strings.Repeat("x", 36) + "'\n",
+		"SECURITY.md":           "# Security\n",
+		"LICENSE":               "All rights reserved.\n",
+		"CODE_OF_CONDUCT.md":    "# Code of Conduct\n",
+		"CONTRIBUTING.md":       "# Contributing\n\nOpen a pull request.\n",
+		"GOVERNANCE.md":         "# Governance\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(flawed, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	for name, dir := range map[string]string{"missing": t.TempDir(), "flawed": flawed} {
+		t.Run(name, func(t *testing.T) {
+			result, err := New(dir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if len(result.Recommendations) == 0 {
+				t.Fatal("no recommendations")
+			}
+
+			seen := map[string]string{}
+			for _, rec := range result.Recommendations {
+				if rec.ID == "" || rec.Reference == "" {
+					t.Errorf("%q: ID = %q, Reference = %q, want both set", rec.Description, rec.ID, rec.Reference)
+					continue
+				}
+				if other, ok := seen[rec.ID]; ok {
+					t.Errorf("%q and %q share ID %s", other, rec.Description, rec.ID)
+				}
+				seen[rec.ID] = rec.Description
+			}
+		})
+	}
+}
+
+func TestChecker_CheckSecrets(t *testing.T) {
+	testDir := t.TempDir()
+	content := "header:\n  schema-version: 2.0.0\n  comment: 'token ghp_" + strings.Repeat("x", 36) + "'\n"
+	if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	result, err := New(testDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	found := false
+	for _, rec := range result.Recommendations {
+		if rec.Description == "SECURITY-INSIGHTS.yml may contain a secret" {
+			found = true
+			if rec.Priority != "high" {
+				t.Errorf("priority = %s, want high", rec.Priority)
+			}
+			if !strings.Contains(rec.Action, "header.comment") {
+				t.Errorf("action %q does not name header.comment", rec.Action)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("no secret recommendation in %v", result.Recommendations)
+	}
+}
+
+func TestChecker_CheckOwnership(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	insights := `header:
+  schema-version: 2.0.0
+project:
+  administrators:
+    - name: Alice Example
+      email: alice@example.com
+repository:
+  core-team:
+    - name: Bob
+      email: bob@corp.example
+      social: https://github.com/bobhub
+    - name: Carol
+      email: carol@example.com
+`
+
+	tests := []struct {
+		name        string
+		commits     map[string]int // "Name <email>" to commit count
+		oldCommits  map[string]int // as commits, but outside the window
+		shallow     bool
+		wantStale   string
+		wantMissing string
+	}{
+		{
+			name: "owners matched by email, name and handle",
+			commits: map[string]int{
+				"alice <alice@example.com>":                         1,
+				"Bob Builder <123+bobhub@users.noreply.github.com>": 1,
+				"carol <carol@example.org>":                         1,
+			},
+		},
+		{
+			name:      "owner without commits",
+			commits:   map[string]int{"Alice Example <alice@example.com>": 1, "Bob <bob@corp.example>": 1},
+			wantStale: "Carol",
+		},
+		{
+			name: "prolific committer not listed",
+			commits: map[string]int{
+				"Alice Example <alice@example.com>": 1,
+				"Bob <bob@corp.example>":            1,
+				"Carol <carol@example.com>":         1,
+				"Dave <dave@example.com>":           prolificMinCommits,
+			},
+			wantMissing: "Dave",
+		},
+		{
+			name:       "owner with only old commits",
+			commits:    map[string]int{"Alice Example <alice@example.com>": 1, "Bob <bob@corp.example>": 1},
+			oldCommits: map[string]int{"Carol <carol@example.com>": 1},
+			wantStale:  "Carol",
+		},
+		{
+			name:    "shallow clone is skipped",
+			commits: map[string]int{"Alice Example <alice@example.com>": 1, "Bob <bob@corp.example>": 1},
+			shallow: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(insights), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			git := func(dir, date string, args ...string) {
+				cmd := exec.Command("git", args...)
+				cmd.Dir = dir
+				if date != "" {
+					cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+				}
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("git %v failed: %v (%s)", args, err, out)
+				}
+			}
+			commit := func(date string, commits map[string]int) {
+				for ident, count := range commits {
+					for i := 0; i < count; i++ {
+						git(testDir, date, "-c", "user.name=x", "-c", "user.email=x@x",
+							"commit", "-q", "--allow-empty", "-m", "change", "--author", ident)
+					}
+				}
+			}
+			git(testDir, "", "init", "-q")
+			git(testDir, "", "add", "SECURITY-INSIGHTS.yml")
+			commit("2015-01-01T12:00:00Z", tt.oldCommits)
+			commit("", tt.commits)
+
+			if tt.shallow {
+				clone := t.TempDir()
+				git(clone, "", "clone", "-q", "--depth", "1", "file://"+testDir, ".")
+				testDir = clone
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			var stale, missing string
+			for _, w := range result.Files[0].Warnings {
+				if strings.HasPrefix(w, "Declared owners with no commits") {
+					stale = w
+				}
+				if strings.HasPrefix(w, "Frequent committers not listed") {
+					missing = w
+				}
+			}
+			if (stale != "") != (tt.wantStale != "") || !strings.Contains(stale, tt.wantStale) {
+				t.Errorf("stale owner warning = %q, want one naming %q", stale, tt.wantStale)
+			}
+			if (missing != "") != (tt.wantMissing != "") || !strings.Contains(missing, tt.wantMissing) {
+				t.Errorf("unlisted committer warning = %q, want one naming %q", missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestChecker_MissingRequired(t *testing.T) {
+	tests := []struct {
+		name    string
+		present []string
+		want    []string
+	}{
+		{
+			name: "empty repository",
+			want: []string{"SECURITY-INSIGHTS.yml", "SECURITY.md", "LICENSE"},
+		},
+		{
+			name:    "alternative locations",
+			present: []string{".github/SECURITY-INSIGHTS.yaml", "docs/SECURITY.md", "COPYING"},
+		},
+		{
+			name:    "unrecognised location",
+			present: []string{"SECURITY-INSIGHTS.yml", "docs/LICENSE", "SECURITY.md"},
+			want:    []string{"LICENSE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			present := make(map[string]bool)
+			for _, p := range tt.present {
+				present[p] = true
+			}
+			got := MissingRequired(func(relPath string) bool { return present[relPath] })
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("MissingRequired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecker_CheckPrivateReporting(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		repoURL    string
+		securityMd string
+		apiEnabled *bool
+		want       string
+	}{
+		{name: "email only", status: "active", repoURL: "https://github.com/example/repo",
+			securityMd: "Email security@example.com", want: "SECURITY.md does not offer GitHub private vulnerability reporting"},
+		{name: "advisory link", status: "active", repoURL: "https://github.com/example/repo",
+			securityMd: "Use https://github.com/example/repo/security/advisories/new"},
+		{name: "inactive project", status: "inactive", repoURL: "https://github.com/example/repo",
+			securityMd: "Email security@example.com"},
+		{name: "not on GitHub", status: "active", repoURL: "https://gitlab.com/example/repo",
+			securityMd: "Email security@example.com"},
+		{name: "disabled on GitHub", status: "active", repoURL: "https://github.com/example/repo",
+			securityMd: "Use https://github.com/example/repo/security/advisories/new", apiEnabled: boolPtr(false),
+			want: "GitHub private vulnerability reporting is disabled"},
+		{name: "enabled on GitHub", status: "active", repoURL: "https://github.com/example/repo",
+			securityMd: "Email security@example.com", apiEnabled: boolPtr(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+
+			content := "header:\n  schema-version: 2.0.0\nrepository:\n  url: " + tt.repoURL + "\n  status: " + tt.status + "\n"
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY-INSIGHTS.yml"), []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(testDir, "SECURITY.md"), []byte(tt.securityMd), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			c := New(testDir)
+			if tt.apiEnabled != nil {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/repos/example/repo/private-vulnerability-reporting" {
+						http.NotFound(w, r)
+						return
+					}
+					fmt.Fprintf(w, `{"enabled": %t}`, *tt.apiEnabled)
+				}))
+				defer server.Close()
+
+				client := github.NewClient()
+				client.SetBaseURL(server.URL)
+				c.SetGitHubClient(client)
+			}
+
+			result, err := c.Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			var got []string
+			for _, rec := range result.Recommendations {
+				if strings.Contains(rec.Description, "private vulnerability reporting") {
+					got = append(got, rec.Description)
+				}
+			}
+			if tt.want == "" {
+				if len(got) > 0 {
+					t.Errorf("unexpected recommendations: %v", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0] != tt.want {
+				t.Errorf("recommendations = %v, want [%s]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecker_RuleOutcomes(t *testing.T) {
+	testDir := t.TempDir()
+	mit, _ := license.Text("MIT")
+	files := map[string]string{
+		"LICENSE":         mit,
+		"SECURITY.md":     "# Security\n",
+		"CONTRIBUTING.md": "# Contributing\n\nOpen a pull request.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	result, err := New(testDir).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	want := map[string]string{
+		"security-insights-present": StatusFail,
+		"security-insights-valid":   StatusSkip,
+		"license-present":           StatusPass,
+		"license-valid":             StatusPass,
+		"contributing-present":      StatusPass,
+		"contributing-valid":        StatusWarn,
+		"governance-present":        StatusFail,
+	}
+	for rule, status := range want {
+		outcome, ok := result.Checks[rule]
+		if !ok {
+			t.Errorf("Checks[%q] is missing", rule)
+			continue
+		}
+		if outcome.Status != status {
+			t.Errorf("Checks[%q].Status = %q, want %q (messages: %v)", rule, outcome.Status, status, outcome.Messages)
+		}
+	}
+
+	if got := result.Checks["security-insights-present"].Severity; got != "high" {
+		t.Errorf("security-insights-present severity = %q, want high", got)
+	}
+	if msgs := result.Checks["contributing-valid"].Messages; len(msgs) == 0 {
+		t.Error("contributing-valid has no messages for its warning")
+	}
+}
+
+func TestChecker_Levels(t *testing.T) {
+	// LICENSE and SECURITY.md meet level 1; SECURITY-INSIGHTS.yml, which
+	// level 2 requires, is missing
+	testDir := t.TempDir()
+	mit, _ := license.Text("MIT")
+	files := map[string]string{
+		"LICENSE":     mit,
+		"SECURITY.md": "# Security\n\n## Reporting a Vulnerability\n\nEmail security@example.com; we respond within 48 hours.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		level         int
+		wantCompliant bool
+		wantMissing   []string
+	}{
+		{level: 1, wantCompliant: true, wantMissing: []string{}},
+		{level: 2, wantCompliant: false, wantMissing: []string{"SECURITY-INSIGHTS.yml"}},
+		{level: 3, wantCompliant: false, wantMissing: []string{"SECURITY-INSIGHTS.yml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("level %d", tt.level), func(t *testing.T) {
+			c := New(testDir)
+			c.SetLevel(tt.level)
+			result, err := c.Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			if result.IsCompliant != tt.wantCompliant {
+				t.Errorf("IsCompliant = %v, want %v (missing: %v)", result.IsCompliant, tt.wantCompliant, result.MissingFiles)
+			}
+			if fmt.Sprint(result.MissingFiles) != fmt.Sprint(tt.wantMissing) {
+				t.Errorf("MissingFiles = %v, want %v", result.MissingFiles, tt.wantMissing)
+			}
+			if result.Level != tt.level {
+				t.Errorf("Level = %d, want %d", result.Level, tt.level)
+			}
+			for _, file := range result.Files {
+				if FileLevel(file.Name) > tt.level {
+					t.Errorf("Files includes %s, a level %d requirement", file.Name, FileLevel(file.Name))
+				}
+			}
+			for _, rec := range result.Recommendations {
+				if rec.Level > tt.level || rec.Level != FileLevel(rec.File) {
+					t.Errorf("recommendation %q has level %d at level %d", rec.Description, rec.Level, tt.level)
+				}
+			}
+			if outcome, ok := result.Checks["security-insights-present"]; ok != (tt.level >= 2) {
+				t.Errorf("Checks[security-insights-present] = %+v, present %v at level %d", outcome, ok, tt.level)
+			}
+		})
+	}
+}
+
+func TestChecker_LicenseDetection(t *testing.T) {
+	apache, _ := license.Text("Apache-2.0")
+
+	tests := []struct {
+		name         string
+		content      string
+		wantDetected string
+	}{
+		{"apache", apache, "Apache-2.0"},
+		{"unknown", "Copyright 2025 Acme Corp. All rights reserved.\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(testDir, "LICENSE"), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			var licenseCheck FileCheck
+			for _, file := range result.Files {
+				if file.Name == "LICENSE" {
+					licenseCheck = file
+				}
+			}
+			if licenseCheck.Detected != tt.wantDetected {
+				t.Errorf("Detected = %q, want %q", licenseCheck.Detected, tt.wantDetected)
+			}
+
+			recommended := false
+			for _, rec := range result.Recommendations {
+				if rec.Description == "LICENSE is not a recognized open source license" {
+					recommended = true
+				}
+			}
+			if wantWarning := tt.wantDetected == ""; recommended != wantWarning || (len(licenseCheck.Warnings) > 0) != wantWarning {
+				t.Errorf("recommendation = %v, warnings = %v, want warning %v", recommended, licenseCheck.Warnings, wantWarning)
+			}
+		})
+	}
+}
+
+func TestChecker_Config(t *testing.T) {
+	repo := t.TempDir()
+	configPath := filepath.Join(repo, config.FileName)
+	content := "files:\n  CODE_OF_CONDUCT.md:\n    required: true\n    priority: high\n  GOVERNANCE.md:\n    priority: medium\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Lacks the expected sections
+	if err := os.WriteFile(filepath.Join(repo, "GOVERNANCE.md"), []byte("# Governance\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+
+	c := New(repo)
+	if err := c.SetConfig(cfg); err != nil {
+		t.Fatalf("SetConfig() error = %v", err)
+	}
+	result, err := c.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	found := false
+	for _, name := range result.MissingFiles {
+		found = found || name == "CODE_OF_CONDUCT.md"
+	}
+	if !found {
+		t.Errorf("MissingFiles = %v, want CODE_OF_CONDUCT.md", result.MissingFiles)
+	}
+	for _, rec := range result.Recommendations {
+		if rec.ID == RecCodeOfConductMissing && rec.Priority != "high" {
+			t.Errorf("code of conduct recommendation priority = %q, want high", rec.Priority)
+		}
+	}
+	if got := result.Checks["code-of-conduct-present"].Severity; got != "high" {
+		t.Errorf("code-of-conduct-present severity = %q, want high", got)
+	}
+	if !hasRecommendation(result, RecGovernanceIncomplete) {
+		t.Errorf("no governance recommendation in %v", result.Recommendations)
+	}
+	for _, rec := range result.Recommendations {
+		if rec.ID == RecGovernanceIncomplete && rec.Priority != "medium" {
+			t.Errorf("governance recommendation priority = %q, want medium", rec.Priority)
+		}
+	}
+	// CODE_OF_CONDUCT.md and GOVERNANCE.md each weigh one more than by default
+	if result.MaxScore != 19 {
+		t.Errorf("MaxScore = %d, want 19 with the raised priorities", result.MaxScore)
+	}
+
+	// Without a config the defaults apply
+	result, err = New(repo).Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, name := range result.MissingFiles {
+		if name == "CODE_OF_CONDUCT.md" {
+			t.Errorf("CODE_OF_CONDUCT.md is required without a config")
+		}
+	}
+
+	for name, bad := range map[string]*config.Config{
+		"unknown file":     {Files: map[string]config.File{"README.md": {}}},
+		"unknown priority": {Files: map[string]config.File{"LICENSE": {Priority: "urgent"}}},
+	} {
+		if err := New(repo).SetConfig(bad); err == nil {
+			t.Errorf("SetConfig(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestChecker_CodeOwners(t *testing.T) {
+	for _, location := range []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"} {
+		t.Run(location, func(t *testing.T) {
+			testDir := t.TempDir()
+			path := filepath.Join(testDir, filepath.FromSlash(location))
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte("* @maintainer\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			for _, file := range result.Files {
+				if file.Name == "CODEOWNERS" && (!file.Exists || file.Path != path || len(file.Warnings) > 0) {
+					t.Errorf("CODEOWNERS = %+v, want found at %s without warnings", file, path)
+				}
+			}
+			for _, rec := range result.Recommendations {
+				if rec.File == "CODEOWNERS" {
+					t.Errorf("unexpected recommendation: %s", rec.Description)
+				}
+			}
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		result, err := New(t.TempDir()).Check()
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		if !hasRecommendation(result, RecCodeOwnersMissing) {
+			t.Error("no recommendation for the missing CODEOWNERS")
+		}
+	})
+
+	t.Run("no owners", func(t *testing.T) {
+		testDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(testDir, "CODEOWNERS"), []byte("# * @maintainer\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		result, err := New(testDir).Check()
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		if !hasRecommendation(result, RecCodeOwnersEmpty) {
+			t.Error("no recommendation for a CODEOWNERS without owners")
+		}
+	})
+}
+
+
+func TestChecker_ScorecardWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow string
+		want     bool
+	}{
+		{
+			name: "step uses the action",
+			workflow: `name: Scorecard
+on: push
+jobs:
+  analysis:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: ossf/scorecard-action@62b2cac7ed8198b15735ed49ab1e5cf35480ba46 # v2.4.0
+`,
+			want: true,
+		},
+		{
+			name: "only mentioned in a comment and a name",
+			workflow: `# TODO: add ossf/scorecard-action
+name: ossf/scorecard-action
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - run: echo "uses: ossf/scorecard-action@v2"
+`,
+			want: false,
+		},
+		{
+			name:     "unparseable",
+			workflow: "jobs: [ossf/scorecard-action\n",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			dir := filepath.Join(testDir, ".github", "workflows")
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, "ci.yml"), []byte(tt.workflow), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			for _, file := range result.Files {
+				if file.Name == "scorecard.yml" && file.Exists != tt.want {
+					t.Errorf("scorecard workflow found = %v, want %v", file.Exists, tt.want)
+				}
+			}
+			if got := hasRecommendation(result, RecScorecardMissing); got == tt.want {
+				t.Errorf("scorecard recommendation = %v, want %v", got, !tt.want)
+			}
+		})
+	}
+}
+
+// hasRecommendation reports whether result recommends the given ID
+func hasRecommendation(result *CheckResult, id string) bool {
+	for _, rec := range result.Recommendations {
+		if rec.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestChecker_DependencyUpdates(t *testing.T) {
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{".github/dependabot.yml", "dependabot"},
+		{".github/dependabot.yaml", "dependabot"},
+		{"renovate.json", "renovate"},
+		{".renovaterc", "renovate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			testDir := t.TempDir()
+			path := filepath.Join(testDir, filepath.FromSlash(tt.location))
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte("{}\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := New(testDir).Check()
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			for _, file := range result.Files {
+				if file.Name == "dependabot.yml" && (!file.Exists || file.Path != path || file.Detected != tt.want) {
+					t.Errorf("dependabot.yml = %+v, want %s found at %s", file, tt.want, path)
+				}
+			}
+			if hasRecommendation(result, RecDepUpdatesMissing) {
+				t.Error("unexpected recommendation for missing dependency updates")
+			}
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		result, err := New(t.TempDir()).Check()
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		for _, rec := range result.Recommendations {
+			if rec.ID == RecDepUpdatesMissing && rec.Priority != "medium" {
+				t.Errorf("priority = %q, want medium", rec.Priority)
+			}
+		}
+		if !hasRecommendation(result, RecDepUpdatesMissing) {
+			t.Error("no recommendation for missing dependency updates")
+		}
+	})
+}
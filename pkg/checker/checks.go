@@ -0,0 +1,403 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+	"github.com/aguamala/baseline-init/pkg/license"
+	"github.com/aguamala/baseline-init/pkg/manifest"
+	"github.com/aguamala/baseline-init/pkg/validator"
+)
+
+// How a file found by a check is validated
+const (
+	validateInsights  = "insights"  // validator.ValidateFile; unreadable files are invalid
+	validateDocument  = "document"  // validator.ValidateFile, when the file can be read
+	validateLicense   = "license"   // matched against known license texts
+	validateUpdates   = "updates"   // the dependency update tool is named from the file
+	validateScorecard = "scorecard" // only workflows that run the Scorecard action count
+)
+
+// CheckDefinition describes one file check: what it looks for, where, and
+// how much it matters. Check runs them in order, and list-checks prints them.
+type CheckDefinition struct {
+	// ID is the prefix of the check's rule IDs, as in "<id>-present" and
+	// "<id>-valid". IDs are part of the JSON contract: never rename one,
+	// add a new ID instead.
+	ID       string `json:"id"`
+	File     string `json:"file"`
+	Category string `json:"category"`
+	// Priority of a missing or invalid file: critical, high, medium or low
+	Priority string `json:"priority"`
+	// Level is the baseline maturity level that requires the file
+	Level int `json:"level"`
+	// Required files make a repository non-compliant when missing; the
+	// others are only recommended
+	Required bool `json:"required"`
+	// Locations are the repository-relative paths where the file is
+	// accepted, in order of preference. They may be path.Match patterns,
+	// matched in name order.
+	Locations []string `json:"locations"`
+
+	// validate is how a found file is validated; files of checks without
+	// one only need to exist
+	validate string
+	// missing is the recommendation made when no location holds the file
+	missing Recommendation
+}
+
+// Checks are the file checks, in the order they run and are reported
+var Checks = []CheckDefinition{
+	{
+		ID:       "security-insights",
+		File:     "SECURITY-INSIGHTS.yml",
+		Category: "Security Metadata",
+		Priority: "high",
+		Level:    2,
+		Required: true,
+		Locations: []string{
+			"SECURITY-INSIGHTS.yml",
+			".github/SECURITY-INSIGHTS.yml",
+			"SECURITY-INSIGHTS.yaml",
+			".github/SECURITY-INSIGHTS.yaml",
+		},
+		validate: validateInsights,
+		missing: Recommendation{
+			ID:          RecInsightsMissing,
+			Description: "SECURITY-INSIGHTS.yml file is missing",
+			Action:      "Run 'baseline-init setup --auto' to generate this file",
+			Reference:   insightsReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "security-policy",
+		File:      "SECURITY.md",
+		Category:  "Security Policy",
+		Priority:  "medium",
+		Level:     1,
+		Required:  true,
+		Locations: []string{"SECURITY.md", ".github/SECURITY.md", "docs/SECURITY.md"},
+		validate:  validateDocument,
+		missing: Recommendation{
+			ID:          RecPolicyMissing,
+			Description: "SECURITY.md file is missing",
+			Action:      "Create a SECURITY.md file documenting your security policy",
+			Reference:   baselineReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "license",
+		File:      "LICENSE",
+		Category:  "Legal",
+		Priority:  "high",
+		Level:     1,
+		Required:  true,
+		Locations: []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING"},
+		validate:  validateLicense,
+		missing: Recommendation{
+			ID:          RecLicenseMissing,
+			Description: "LICENSE file is missing",
+			Action:      "Add an appropriate open source license to your repository",
+			Reference:   baselineReference,
+			Effort:      "policy",
+		},
+	},
+	{
+		ID:        "code-of-conduct",
+		File:      "CODE_OF_CONDUCT.md",
+		Category:  "Community",
+		Priority:  "medium",
+		Level:     2,
+		Locations: []string{"CODE_OF_CONDUCT.md", ".github/CODE_OF_CONDUCT.md", "docs/CODE_OF_CONDUCT.md"},
+		missing: Recommendation{
+			ID:          RecCodeOfConductMissing,
+			Description: "CODE_OF_CONDUCT.md file is missing",
+			Action:      "Run 'baseline-init setup --auto' to generate a code of conduct based on the Contributor Covenant",
+			Reference:   codeOfConductReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "contributing",
+		File:      "CONTRIBUTING.md",
+		Category:  "Community",
+		Priority:  "low",
+		Level:     1,
+		Locations: []string{"CONTRIBUTING.md", ".github/CONTRIBUTING.md", "docs/CONTRIBUTING.md"},
+		missing: Recommendation{
+			ID:          RecContributingMissing,
+			Description: "CONTRIBUTING.md file is missing",
+			Action:      "Run 'baseline-init setup --auto' to generate contribution guidelines",
+			Reference:   baselineReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "governance",
+		File:      "GOVERNANCE.md",
+		Category:  "Governance",
+		Priority:  "low",
+		Level:     2,
+		Locations: []string{"GOVERNANCE.md", ".github/GOVERNANCE.md", "docs/GOVERNANCE.md"},
+		validate:  validateDocument,
+		missing: Recommendation{
+			ID:          RecGovernanceMissing,
+			Description: "GOVERNANCE.md file is missing",
+			Action:      "Run 'baseline-init setup --auto' to generate a governance document",
+			Reference:   baselineReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "codeowners",
+		File:      "CODEOWNERS",
+		Category:  "Governance",
+		Priority:  "medium",
+		Level:     2,
+		Locations: []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"},
+		missing: Recommendation{
+			ID:          RecCodeOwnersMissing,
+			Description: "CODEOWNERS file is missing",
+			Action:      "Run 'baseline-init setup --auto' to generate a CODEOWNERS file that makes the maintainers review every change",
+			Reference:   codeOwnersReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:       "dependency-updates",
+		File:     "dependabot.yml",
+		Category: "Supply Chain",
+		Priority: "medium",
+		Level:    2,
+		Locations: []string{
+			".github/dependabot.yml",
+			".github/dependabot.yaml",
+			"renovate.json",
+			"renovate.json5",
+			".renovaterc",
+			".renovaterc.json",
+			".github/renovate.json",
+		},
+		validate: validateUpdates,
+		missing: Recommendation{
+			ID:          RecDepUpdatesMissing,
+			Description: "No automated dependency updates are configured (Dependabot or Renovate)",
+			Action:      "Run 'baseline-init setup --auto' to generate .github/dependabot.yml, or configure Renovate",
+			Reference:   baselineReference,
+			Effort:      "auto",
+		},
+	},
+	{
+		ID:        "scorecard",
+		File:      "scorecard.yml",
+		Category:  "Supply Chain",
+		Priority:  "low",
+		Level:     3,
+		Locations: []string{".github/workflows/*.yml", ".github/workflows/*.yaml"},
+		validate:  validateScorecard,
+		missing: Recommendation{
+			ID:          RecScorecardMissing,
+			Description: "No workflow runs the OpenSSF Scorecard action",
+			Action:      "Run 'baseline-init generate-workflow scorecard' to add a Scorecard workflow",
+			Reference:   scorecardReference,
+			// setup --auto does not add workflows
+			Effort: "manual",
+		},
+	},
+}
+
+// RepositoryCheck is a check that looks at the repository as a whole, or
+// at the contents of the files found, rather than for one file. --only and
+// --skip select these by ID along with the file checks.
+type RepositoryCheck struct {
+	ID          string `json:"id"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+}
+
+// RepositoryChecks are the repository checks, in the order they run: funding
+// and secrets while SECURITY-INSIGHTS.yml is reviewed, the others after the
+// file checks
+var RepositoryChecks = []RepositoryCheck{
+	{ID: "funding", Category: "Sustainability", Description: "Active projects with several administrators declare a funding model"},
+	{ID: "secrets", Category: "Security Metadata", Description: "SECURITY-INSIGHTS.yml contains no credentials"},
+	{ID: "manifest", Category: "Provenance", Description: "Files recorded by 'setup --manifest' have not changed since they were generated"},
+	{ID: "file-modes", Category: "Hygiene", Description: "Compliance files are not writable by group or others"},
+	{ID: "tracking", Category: "Hygiene", Description: "Compliance files are tracked by git"},
+}
+
+// checkFor returns the definition of the check for the named file
+func checkFor(file string) (CheckDefinition, bool) {
+	for _, def := range Checks {
+		if def.File == file {
+			return def, true
+		}
+	}
+	return CheckDefinition{}, false
+}
+
+// missingRecommendation is the recommendation for a file the check did not
+// find, filled in from the definition
+func (def CheckDefinition) missingRecommendation() Recommendation {
+	rec := def.missing
+	rec.Priority = def.Priority
+	rec.Category = def.Category
+	rec.File = def.File
+	return rec
+}
+
+// CandidatePaths returns every repository-relative path a check reads, so
+// that callers fetching a repository over an API know what to download. Some
+// are patterns, as in CheckDefinition.Locations.
+func CandidatePaths() []string {
+	var paths []string
+	for _, def := range Checks {
+		paths = append(paths, def.Locations...)
+	}
+	for _, path := range fundingFiles {
+		paths = append(paths, filepath.ToSlash(path))
+	}
+	paths = append(paths, archivalMarkers...)
+	return append(paths, manifest.FileName)
+}
+
+// MissingRequired returns the names of the required files for which exists
+// reports none of the accepted locations. It lets callers that cannot read
+// the repository from disk, such as API-based checks, apply the same rules.
+func MissingRequired(exists func(relPath string) bool) []string {
+	var missing []string
+	for _, def := range Checks {
+		if !def.Required {
+			continue
+		}
+		found := false
+		for _, location := range def.Locations {
+			if exists(location) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, def.File)
+		}
+	}
+	return missing
+}
+
+// requiredPaths returns the on-disk candidates for the named checked file,
+// expanding location patterns to the files that match them
+func (c *Checker) requiredPaths(name string) []string {
+	var paths []string
+	if def, ok := checkFor(name); ok {
+		for _, location := range def.Locations {
+			path := filepath.Join(c.repoPath, filepath.FromSlash(location))
+			if !isPattern(location) {
+				paths = append(paths, path)
+				continue
+			}
+			matches, _ := filepath.Glob(path)
+			paths = append(paths, matches...)
+		}
+	}
+	return paths
+}
+
+// Locate returns the repository-relative path, with forward slashes, at
+// which repoPath holds the file of the check for file, such as
+// "docs/SECURITY.md" for "SECURITY.md". It lets generators avoid adding a
+// second copy at another accepted location.
+func Locate(repoPath, file string) (string, bool) {
+	c := &Checker{repoPath: repoPath}
+	for _, path := range c.requiredPaths(file) {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(repoPath, path); err == nil {
+			return filepath.ToSlash(rel), true
+		}
+	}
+	return "", false
+}
+
+// isPattern reports whether a check location is a pattern rather than a path
+func isPattern(location string) bool {
+	return strings.ContainsAny(location, "*?[")
+}
+
+// inspect looks for the file of a check at each of its locations and, once
+// found, validates it as the definition asks
+func (c *Checker) inspect(def CheckDefinition) FileCheck {
+	for _, path := range c.requiredPaths(def.File) {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		check := FileCheck{
+			Name:   def.File,
+			Path:   path,
+			Exists: true,
+			Valid:  true,
+		}
+		switch def.validate {
+		case validateInsights:
+			// CI checkouts are often shallow, where history-based rules
+			// would judge against a truncated log
+			v := validator.New()
+			if shallow, err := gitutil.IsShallow(c.repoPath); err == nil && shallow {
+				v.SetHistoryChecks(false)
+			}
+			result, err := v.ValidateFile(path)
+			if err != nil {
+				check.Valid = false
+				check.Errors = append(check.Errors, err.Error())
+				return check
+			}
+			check.Valid = result.IsValid
+			check.Errors = append(check.Errors, result.Errors...)
+			for _, warning := range result.Warnings {
+				// checkSecrets reports these along with a recommendation
+				if !strings.HasPrefix(warning, "Possible secret:") {
+					check.Warnings = append(check.Warnings, warning)
+				}
+			}
+		case validateDocument:
+			if result, err := validator.New().ValidateFile(path); err == nil {
+				check.Valid = result.IsValid
+				check.Errors = append(check.Errors, result.Errors...)
+				check.Warnings = append(check.Warnings, result.Warnings...)
+			}
+		case validateLicense:
+			if data, err := os.ReadFile(path); err == nil {
+				if id, ok := license.Detect(string(data)); ok {
+					check.Detected = id
+				} else {
+					check.Warnings = append(check.Warnings, "License text does not match a known SPDX license")
+				}
+			}
+		case validateUpdates:
+			check.Detected = "renovate"
+			if strings.HasPrefix(filepath.Base(path), "dependabot.") {
+				check.Detected = "dependabot"
+			}
+		case validateScorecard:
+			if !runsScorecard(path) {
+				continue
+			}
+		}
+		return check
+	}
+
+	return FileCheck{
+		Name:   def.File,
+		Path:   "",
+		Exists: false,
+		Valid:  false,
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// projectMarkers are files or directories that make a directory a project
+// root of its own inside a monorepo
+var projectMarkers = []string{".git", "go.mod", "package.json", "Cargo.toml", "pyproject.toml", "pom.xml"}
+
+// skippedDirs are never searched for projects: vendored dependencies and
+// build caches hold other people's projects. Hidden directories are skipped
+// too.
+var skippedDirs = map[string]bool{
+	"vendor":       true,
+	"node_modules": true,
+	"testdata":     true,
+}
+
+// MultiCheckResult contains the results of checking each project in a
+// monorepo
+type MultiCheckResult struct {
+	Root     string         `json:"root"`
+	Projects []*CheckResult `json:"projects"`
+	Partial  bool           `json:"partial,omitempty"` // some projects were not checked, e.g. on timeout
+
+	// Warnings name the directories that could not be searched for projects
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// FindProjects returns root followed by every directory below it that holds
+// a project marker such as go.mod, package.json or .git, searching at most
+// maxDepth levels deep. A maxDepth of zero or less means no limit.
+// Directories below root that cannot be read are skipped and returned as
+// warnings.
+func FindProjects(root string, maxDepth int) ([]string, []string, error) {
+	projects := []string{root}
+	var warnings []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			warnings = append(warnings, fmt.Sprintf("Skipped %s: %v", path, err))
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") || skippedDirs[d.Name()] {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		depth := len(strings.Split(rel, string(filepath.Separator)))
+		if maxDepth > 0 && depth > maxDepth {
+			return filepath.SkipDir
+		}
+
+		if isProjectRoot(path) {
+			projects = append(projects, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search %s for projects: %w", root, err)
+	}
+	return projects, warnings, nil
+}
+
+// isProjectRoot reports whether dir holds any project marker
+func isProjectRoot(dir string) bool {
+	for _, marker := range projectMarkers {
+		if _, err := os.Stat(filepath.Join(dir, marker)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckRecursive checks the repository root and each project found below it,
+// as FindProjects does
+func (c *Checker) CheckRecursive(maxDepth int) (*MultiCheckResult, error) {
+	return c.CheckRecursiveContext(context.Background(), maxDepth)
+}
+
+// SetProgress sets a function CheckRecursiveContext calls after checking each
+// project, with the number checked so far, the number found and the path
+func (c *Checker) SetProgress(progress func(done, total int, path string)) {
+	c.progress = progress
+}
+
+// CheckRecursiveContext checks each project like CheckRecursive. Once ctx is
+// done, remaining projects are reported as partial without being checked.
+func (c *Checker) CheckRecursiveContext(ctx context.Context, maxDepth int) (*MultiCheckResult, error) {
+	paths, warnings, err := FindProjects(c.repoPath, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	multi := &MultiCheckResult{Root: c.repoPath, Projects: []*CheckResult{}, Warnings: warnings}
+	for i, path := range paths {
+		project := *c
+		project.repoPath = path
+		result, err := project.CheckContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("compliance check failed for %s: %w", path, err)
+		}
+		if result.Partial {
+			multi.Partial = true
+		}
+		multi.Projects = append(multi.Projects, result)
+		if c.progress != nil {
+			c.progress(i+1, len(paths), path)
+		}
+	}
+	return multi, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChecker_CheckRecursive(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                                "module example.com/mono\n",
+		"services/api/go.mod":                   "module example.com/api\n",
+		"services/api/SECURITY-INSIGHTS.yml":    validInsights,
+		"web/app/package.json":                  "{}\n",
+		"web/app/node_modules/dep/package.json": "{}\n",
+		"vendor/example.com/lib/go.mod":         "module example.com/lib\n",
+		".github/workflows/go.mod":              "module example.com/hidden\n",
+		"docs/README.md":                        "# Docs\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	multi, err := New(root).CheckRecursive(0)
+	if err != nil {
+		t.Fatalf("CheckRecursive() error = %v", err)
+	}
+
+	want := []string{root, filepath.Join(root, "services", "api"), filepath.Join(root, "web", "app")}
+	if len(multi.Projects) != len(want) {
+		var got []string
+		for _, project := range multi.Projects {
+			got = append(got, project.Path)
+		}
+		t.Fatalf("projects = %v, want %v", got, want)
+	}
+	for i, path := range want {
+		if multi.Projects[i].Path != path {
+			t.Errorf("Projects[%d].Path = %s, want %s", i, multi.Projects[i].Path, path)
+		}
+	}
+
+	// Each project is checked on its own files
+	api := multi.Projects[1]
+	if len(api.MissingFiles) != 2 {
+		t.Errorf("services/api missing files = %v, want SECURITY.md and LICENSE", api.MissingFiles)
+	}
+	if len(multi.Projects[2].MissingFiles) != 3 {
+		t.Errorf("web/app missing files = %v, want all three required files", multi.Projects[2].MissingFiles)
+	}
+
+	// A depth limit stops before the nested projects
+	shallow, err := New(root).CheckRecursive(1)
+	if err != nil {
+		t.Fatalf("CheckRecursive(1) error = %v", err)
+	}
+	if len(shallow.Projects) != 1 {
+		t.Errorf("CheckRecursive(1) found %d projects, want only the root", len(shallow.Projects))
+	}
+}
+
+func TestChecker_CheckRecursiveProgress(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"a/go.mod", "b/go.mod"} {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte("module example.com/x\n"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	var calls []string
+	c := New(root)
+	c.SetProgress(func(done, total int, path string) {
+		calls = append(calls, fmt.Sprintf("%d/%d %s", done, total, filepath.Base(path)))
+	})
+	if _, err := c.CheckRecursive(0); err != nil {
+		t.Fatalf("CheckRecursive() error = %v", err)
+	}
+
+	want := []string{"1/3 " + filepath.Base(root), "2/3 a", "3/3 b"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("progress calls = %v, want %v", calls, want)
+	}
+}
+
+func TestFindProjects_UnreadableDir(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("directory permissions are not enforced for root")
+	}
+
+	root := t.TempDir()
+	for _, name := range []string{"a/go.mod", "locked/b/go.mod"} {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte("module example.com/x\n"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	locked := filepath.Join(root, "locked")
+	if err := os.Chmod(locked, 0); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chmod(locked, 0755) })
+
+	projects, warnings, err := FindProjects(root, 0)
+	if err != nil {
+		t.Fatalf("FindProjects() error = %v, want the unreadable directory skipped", err)
+	}
+	if want := []string{root, filepath.Join(root, "a")}; !reflect.DeepEqual(projects, want) {
+		t.Errorf("FindProjects() projects = %v, want %v", projects, want)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], locked) {
+		t.Errorf("FindProjects() warnings = %v, want one naming %s", warnings, locked)
+	}
+
+	if _, _, err := FindProjects(filepath.Join(root, "missing"), 0); err == nil {
+		t.Error("FindProjects() on a missing root succeeded")
+	}
+}
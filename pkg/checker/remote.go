@@ -0,0 +1,157 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/github"
+)
+
+// CheckRemote checks the default branch of owner/name on GitHub without
+// cloning it. The files a local check reads are fetched through the contents
+// API into a temporary directory and checked there, so the result has the
+// same layout, with paths under github.com/owner/name. It needs a client set
+// with SetGitHubClient.
+func (c *Checker) CheckRemote(ctx context.Context, owner, name string) (*CheckResult, error) {
+	if c.githubClient == nil {
+		return nil, fmt.Errorf("checking a remote repository requires a GitHub client")
+	}
+
+	// Fail on a missing or inaccessible repository rather than reporting
+	// every file as missing
+	if _, err := c.githubClient.GetRepository(ctx, owner, name); err != nil {
+		var apiErr *github.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("repository %s/%s not found, or private and GITHUB_TOKEN cannot read it", owner, name)
+		}
+		return nil, fmt.Errorf("failed to look up %s/%s: %w", owner, name, err)
+	}
+
+	dir, err := os.MkdirTemp("", "baseline-remote-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := c.fetchCandidates(ctx, owner, name, dir); err != nil {
+		return nil, err
+	}
+
+	label := path.Join("github.com", owner, name)
+	local := *c
+	local.repoPath = dir
+	local.remoteURL = "https://" + label
+	result, err := local.CheckContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relabel(result, dir, label)
+	return result, nil
+}
+
+// fetchCandidates downloads each of CandidatePaths that exists in owner/name
+// into dir. Directories are listed first so that only files which exist are
+// requested, keeping the check to a handful of API calls.
+func (c *Checker) fetchCandidates(ctx context.Context, owner, name, dir string) error {
+	candidates := CandidatePaths()
+
+	listed := map[string]bool{}
+	present := map[string]bool{}
+	var entries []string
+	for _, candidate := range candidates {
+		parent := path.Dir(candidate)
+		if parent == "." {
+			parent = ""
+		}
+		if listed[parent] {
+			continue
+		}
+		listed[parent] = true
+
+		listing, err := c.githubClient.ListDirectory(ctx, owner, name, parent)
+		if err != nil {
+			return fmt.Errorf("failed to list %s/%s: %w", owner, name, err)
+		}
+		for _, entry := range listing {
+			present[entry] = true
+		}
+		entries = append(entries, listing...)
+	}
+
+	// Patterns, such as workflow files, stand for every listed entry they match
+	var files []string
+	for _, candidate := range candidates {
+		if !isPattern(candidate) {
+			if present[candidate] {
+				files = append(files, candidate)
+			}
+			continue
+		}
+		for _, entry := range entries {
+			if ok, _ := path.Match(candidate, entry); ok {
+				files = append(files, entry)
+			}
+		}
+	}
+
+	for _, candidate := range files {
+		content, found, err := c.githubClient.GetFileContent(ctx, owner, name, candidate)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s from %s/%s: %w", candidate, owner, name, err)
+		}
+		if !found {
+			continue
+		}
+
+		local := filepath.Join(dir, filepath.FromSlash(candidate))
+		if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", candidate, err)
+		}
+		if err := os.WriteFile(local, content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", candidate, err)
+		}
+	}
+	return nil
+}
+
+// relabel replaces the temporary directory in result with label, so paths
+// and messages name the remote repository
+func relabel(result *CheckResult, dir, label string) {
+	replace := func(s string) string {
+		s = strings.ReplaceAll(s, dir+string(filepath.Separator), label+"/")
+		return strings.ReplaceAll(s, dir, label)
+	}
+	replaceAll := func(list []string) {
+		for i := range list {
+			list[i] = replace(list[i])
+		}
+	}
+
+	result.Path = label
+	for i := range result.Files {
+		file := &result.Files[i]
+		if file.Path != "" {
+			file.Path = filepath.ToSlash(replace(file.Path))
+		}
+		replaceAll(file.Errors)
+		replaceAll(file.Warnings)
+	}
+	for i := range result.Recommendations {
+		rec := &result.Recommendations[i]
+		rec.Description = replace(rec.Description)
+		rec.Action = replace(rec.Action)
+	}
+	for id, outcome := range result.Checks {
+		replaceAll(outcome.Messages)
+		result.Checks[id] = outcome
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/github"
+	"github.com/aguamala/baseline-init/pkg/license"
+)
+
+// contentsAPI stubs the GitHub repository and contents endpoints for
+// example/repo, serving files from its root
+func contentsAPI(t *testing.T, files map[string]string) *github.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/repos/example/repo"
+		path := strings.TrimPrefix(r.URL.Path, prefix)
+		switch {
+		case path == "":
+			json.NewEncoder(w).Encode(map[string]string{"name": "repo", "full_name": "example/repo"})
+		case path == "/contents/":
+			var entries []map[string]string
+			for name := range files {
+				entries = append(entries, map[string]string{"path": name})
+			}
+			json.NewEncoder(w).Encode(entries)
+		case strings.HasPrefix(path, "/contents/") && files[strings.TrimPrefix(path, "/contents/")] != "":
+			content := files[strings.TrimPrefix(path, "/contents/")]
+			json.NewEncoder(w).Encode(map[string]string{
+				"type":     "file",
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte(content)),
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := github.NewClient()
+	client.SetBaseURL(server.URL)
+	return client
+}
+
+func TestChecker_CheckRemote(t *testing.T) {
+	mit, _ := license.Text("MIT")
+	client := contentsAPI(t, map[string]string{
+		"SECURITY-INSIGHTS.yml": validInsights,
+		"LICENSE":               mit,
+	})
+
+	c := New("")
+	c.SetGitHubClient(client)
+	result, err := c.CheckRemote(context.Background(), "example", "repo")
+	if err != nil {
+		t.Fatalf("CheckRemote() error = %v", err)
+	}
+
+	if result.Path != "github.com/example/repo" {
+		t.Errorf("Path = %s, want github.com/example/repo", result.Path)
+	}
+	if len(result.MissingFiles) != 1 || result.MissingFiles[0] != "SECURITY.md" {
+		t.Errorf("MissingFiles = %v, want [SECURITY.md]", result.MissingFiles)
+	}
+
+	insights := result.Files[0]
+	if !insights.Exists || !insights.Valid || insights.Path != "github.com/example/repo/SECURITY-INSIGHTS.yml" {
+		t.Errorf("SECURITY-INSIGHTS.yml check = %+v", insights)
+	}
+	for _, file := range result.Files {
+		if file.Name == "LICENSE" && file.Detected != "MIT" {
+			t.Errorf("LICENSE detected = %q, want MIT", file.Detected)
+		}
+	}
+}
+
+func TestChecker_CheckRemoteErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{name: "repository not found", status: http.StatusNotFound, wantErr: "not found"},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: "401"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"message": "denied"}`))
+			}))
+			defer server.Close()
+
+			client := github.NewClient()
+			client.SetBaseURL(server.URL)
+			c := New("")
+			c.SetGitHubClient(client)
+
+			_, err := c.CheckRemote(context.Background(), "example", "repo")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("CheckRemote() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+// Rule outcome statuses
+const (
+	StatusPass = "pass"
+	StatusWarn = "warn" // passed, with warnings
+	StatusFail = "fail"
+	StatusSkip = "skip" // not applicable, e.g. validating a missing file
+)
+
+// OpenSSF Security Baseline maturity levels. Each level adds requirements
+// to those of the levels below it.
+const (
+	MinLevel = 1
+	MaxLevel = 3
+)
+
+// CheckOutcome is the result of one rule
+type CheckOutcome struct {
+	Status   string   `json:"status" yaml:"status"`     // pass, warn, fail or skip
+	Severity string   `json:"severity" yaml:"severity"` // priority of a failure: critical, high, medium or low
+	Level    int      `json:"level" yaml:"level"`       // baseline maturity level that requires the rule
+	Messages []string `json:"messages,omitempty" yaml:"messages,omitempty"`
+}
+
+// RuleName returns the rule ID prefix for a checked file, such as
+// "security-insights" for SECURITY-INSIGHTS.yml, or "" for other files
+func RuleName(file string) string {
+	if def, ok := checkFor(file); ok {
+		return def.ID
+	}
+	return ""
+}
+
+// Recommendation IDs identify each kind of recommendation. Like rule IDs
+// they are part of the JSON contract: never renumber or reuse one.
+const (
+	RecInsightsMissing         = "BASELINE-SEC-001"
+	RecInsightsInvalid         = "BASELINE-SEC-002"
+	RecInsightsRemoteMismatch  = "BASELINE-SEC-003"
+	RecInsightsHeaderURL       = "BASELINE-SEC-004"
+	RecInsightsStaleStatus     = "BASELINE-SEC-005"
+	RecInsightsArchivalStatus  = "BASELINE-SEC-006"
+	RecInsightsDuplicate       = "BASELINE-SEC-007"
+	RecInsightsSecret          = "BASELINE-SEC-008"
+	RecPolicyMissing           = "BASELINE-POL-001"
+	RecPolicyIncomplete        = "BASELINE-POL-002"
+	RecPrivateReportingOff     = "BASELINE-POL-003"
+	RecPrivateReportingUnnamed = "BASELINE-POL-004"
+	RecLicenseMissing          = "BASELINE-LEG-001"
+	RecLicenseUnrecognized     = "BASELINE-LEG-002"
+	RecCodeOfConductMissing    = "BASELINE-COM-001"
+	RecContributingMissing     = "BASELINE-COM-002"
+	RecContributingNoPolicy    = "BASELINE-COM-003"
+	RecGovernanceMissing       = "BASELINE-GOV-001"
+	RecGovernanceIncomplete    = "BASELINE-GOV-002"
+	RecCodeOwnersMissing       = "BASELINE-GOV-003"
+	RecCodeOwnersEmpty         = "BASELINE-GOV-004"
+	RecFundingMissing          = "BASELINE-SUS-001"
+	RecDepUpdatesMissing       = "BASELINE-SUP-001"
+	RecScorecardMissing        = "BASELINE-SUP-002"
+	RecOwnersWithoutCommits    = "BASELINE-OWN-001"
+	RecCommittersNotListed     = "BASELINE-OWN-002"
+	RecManifestUnreadable      = "BASELINE-PRV-001"
+	RecManifestDrift           = "BASELINE-PRV-002"
+	RecFileWritable            = "BASELINE-HYG-001"
+	RecFileUntracked           = "BASELINE-HYG-002"
+)
+
+// References for recommendations, pointing at the document that sets out
+// the requirement
+const (
+	baselineReference      = "https://github.com/ossf/security-baseline"
+	insightsReference      = "https://github.com/ossf/security-insights-spec"
+	codeOfConductReference = "https://www.contributor-covenant.org"
+	scorecardReference     = "https://github.com/ossf/scorecard-action"
+	codeOwnersReference    = "https://docs.github.com/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners"
+)
+
+// FileLevel returns the baseline level that requires a checked file, such as
+// 2 for SECURITY-INSIGHTS.yml. Other files, and findings that concern no
+// file, belong to every level.
+func FileLevel(file string) int {
+	if def, ok := checkFor(file); ok {
+		return def.Level
+	}
+	return MinLevel
+}
+
+// ruleOutcomes derives the "<file>-present" and "<file>-valid" rules of
+// checks from the file checks. Files that were not checked, as in a partial result,
+// have no entries.
+func ruleOutcomes(checks []CheckDefinition, files []FileCheck) map[string]CheckOutcome {
+	outcomes := make(map[string]CheckOutcome)
+	for _, def := range checks {
+		for _, file := range files {
+			if file.Name != def.File {
+				continue
+			}
+
+			present := CheckOutcome{Status: StatusPass, Severity: def.Priority, Level: def.Level}
+			valid := CheckOutcome{Status: StatusSkip, Severity: def.Priority, Level: def.Level}
+			if !file.Exists {
+				present.Status = StatusFail
+				present.Messages = []string{file.Name + " is missing"}
+			} else {
+				valid.Messages = append(append([]string{}, file.Errors...), file.Warnings...)
+				switch {
+				case !file.Valid || len(file.Errors) > 0:
+					valid.Status = StatusFail
+				case len(file.Warnings) > 0:
+					valid.Status = StatusWarn
+				default:
+					valid.Status = StatusPass
+				}
+			}
+
+			outcomes[def.ID+"-present"] = present
+			outcomes[def.ID+"-valid"] = valid
+		}
+	}
+	return outcomes
+}
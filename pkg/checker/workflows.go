@@ -0,0 +1,70 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import (
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// scorecardAction is the GitHub Action that runs OpenSSF Scorecard
+const scorecardAction = "ossf/scorecard-action"
+
+// workflowUses returns the actions and reusable workflows referenced by the
+// uses: entries of a GitHub Actions workflow file, of both jobs and steps
+func workflowUses(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflow struct {
+		Jobs map[string]struct {
+			Uses  string `yaml:"uses"`
+			Steps []struct {
+				Uses string `yaml:"uses"`
+			} `yaml:"steps"`
+		} `yaml:"jobs"`
+	}
+	if err := yaml.Unmarshal(data, &workflow); err != nil {
+		return nil, err
+	}
+
+	var uses []string
+	for _, job := range workflow.Jobs {
+		if job.Uses != "" {
+			uses = append(uses, job.Uses)
+		}
+		for _, step := range job.Steps {
+			if step.Uses != "" {
+				uses = append(uses, step.Uses)
+			}
+		}
+	}
+	return uses, nil
+}
+
+// usesAction reports whether a uses: reference, such as
+// "ossf/scorecard-action@v2.4.0", names the given action at any version
+func usesAction(uses, action string) bool {
+	name, _, _ := strings.Cut(strings.TrimSpace(uses), "@")
+	return strings.EqualFold(name, action) || strings.HasPrefix(strings.ToLower(name), strings.ToLower(action)+"/")
+}
+
+// runsScorecard reports whether the workflow file at path runs the Scorecard
+// action. Files that cannot be read or parsed do not.
+func runsScorecard(path string) bool {
+	uses, err := workflowUses(path)
+	if err != nil {
+		return false
+	}
+	for _, ref := range uses {
+		if usesAction(ref, scorecardAction) {
+			return true
+		}
+	}
+	return false
+}
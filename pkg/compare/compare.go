@@ -0,0 +1,193 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compare reports field-level differences between two
+// SECURITY-INSIGHTS.yml documents
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of change
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// identityKeys are the fields that identify an entry in a list, tried in
+// order, so that reordering contacts is not reported as a change
+var identityKeys = []string{"name", "email", "value", "url", "id"}
+
+// Change is one field-level difference between two documents
+type Change struct {
+	Path string      `json:"path"` // such as repository.status or project.administrators[Alice]
+	Kind string      `json:"kind"` // added, removed or changed
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// String renders the change on one line
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", c.Path, render(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", c.Path, render(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s → %s", c.Path, render(c.Old), render(c.New))
+	}
+}
+
+// Diff returns the differences between two YAML documents. Mappings are
+// compared key by key in sorted order, and list entries are matched by
+// identity rather than position.
+func Diff(oldData, newData []byte) ([]Change, error) {
+	var oldDoc, newDoc interface{}
+	if err := yaml.Unmarshal(oldData, &oldDoc); err != nil {
+		return nil, fmt.Errorf("failed to parse old document: %w", err)
+	}
+	if err := yaml.Unmarshal(newData, &newDoc); err != nil {
+		return nil, fmt.Errorf("failed to parse new document: %w", err)
+	}
+
+	var changes []Change
+	diff("", oldDoc, newDoc, &changes)
+	return changes, nil
+}
+
+// diff appends the differences between old and new at path
+func diff(path string, oldValue, newValue interface{}, changes *[]Change) {
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldValue == nil:
+		*changes = append(*changes, Change{Path: path, Kind: Added, New: newValue})
+		return
+	case newValue == nil:
+		*changes = append(*changes, Change{Path: path, Kind: Removed, Old: oldValue})
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		diffMaps(path, oldMap, newMap, changes)
+		return
+	}
+
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList {
+		diffLists(path, oldList, newList, changes)
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*changes = append(*changes, Change{Path: path, Kind: Changed, Old: oldValue, New: newValue})
+	}
+}
+
+// diffMaps compares the union of keys in sorted order
+func diffMaps(path string, oldMap, newMap map[string]interface{}, changes *[]Change) {
+	keys := make(map[string]bool, len(oldMap)+len(newMap))
+	for key := range oldMap {
+		keys[key] = true
+	}
+	for key := range newMap {
+		keys[key] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		diff(joinPath(path, key), oldMap[key], newMap[key], changes)
+	}
+}
+
+// diffLists matches entries by identity. Entries present in both lists are
+// compared field by field; the rest are reported as added or removed.
+func diffLists(path string, oldList, newList []interface{}, changes *[]Change) {
+	oldByID, oldOrder := indexList(oldList)
+	newByID, newOrder := indexList(newList)
+
+	for _, id := range oldOrder {
+		entryPath := fmt.Sprintf("%s[%s]", path, id)
+		if newEntry, ok := newByID[id]; ok {
+			diff(entryPath, oldByID[id], newEntry, changes)
+		} else {
+			*changes = append(*changes, Change{Path: entryPath, Kind: Removed, Old: oldByID[id]})
+		}
+	}
+	for _, id := range newOrder {
+		if _, ok := oldByID[id]; !ok {
+			*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%s]", path, id), Kind: Added, New: newByID[id]})
+		}
+	}
+}
+
+// indexList keys each entry by its identity, returning the keys in list
+// order. Entries without a unique identity are keyed by their content.
+func indexList(list []interface{}) (map[string]interface{}, []string) {
+	byID := make(map[string]interface{}, len(list))
+	order := make([]string, 0, len(list))
+	for _, entry := range list {
+		id := identity(entry)
+		if _, taken := byID[id]; taken {
+			id = render(entry)
+		}
+		if _, taken := byID[id]; taken {
+			continue
+		}
+		byID[id] = entry
+		order = append(order, id)
+	}
+	return byID, order
+}
+
+// identity returns the first identity field of a mapping entry, or the
+// entry's content for anything else
+func identity(entry interface{}) string {
+	if m, ok := entry.(map[string]interface{}); ok {
+		for _, key := range identityKeys {
+			if value, ok := m[key].(string); ok && value != "" {
+				return value
+			}
+		}
+	}
+	return render(entry)
+}
+
+// render formats a value compactly for display
+func render(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// joinPath appends key to a dotted path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compare
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare_Diff(t *testing.T) {
+	oldDoc := `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/old
+project:
+  administrators:
+    - name: Alice
+      email: alice@example.com
+    - name: Bob
+      email: bob@example.com
+repository:
+  status: active
+  accepts-change-request: true
+`
+
+	tests := []struct {
+		name    string
+		newDoc  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "reordered and reformatted",
+			newDoc: `# comment
+repository: {accepts-change-request: true, status: active}
+project:
+  administrators:
+    - {email: bob@example.com, name: Bob}
+    - {name: Alice, email: alice@example.com}
+header: {url: 'https://github.com/example/old', schema-version: 2.0.0}
+`,
+		},
+		{
+			name: "field changes and contacts",
+			newDoc: `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/new
+project:
+  administrators:
+    - name: Alice
+      email: alice@example.org
+    - name: Carol
+      email: carol@example.com
+repository:
+  status: inactive
+  bug-fixes-only: true
+`,
+			want: []string{
+				"~ header.url: https://github.com/example/old → https://github.com/example/new",
+				"~ project.administrators[Alice].email: alice@example.com → alice@example.org",
+				"- project.administrators[Bob]: {\"email\":\"bob@example.com\",\"name\":\"Bob\"}",
+				"+ project.administrators[Carol]: {\"email\":\"carol@example.com\",\"name\":\"Carol\"}",
+				"- repository.accepts-change-request: true",
+				"+ repository.bug-fixes-only: true",
+				"~ repository.status: active → inactive",
+			},
+		},
+		{
+			name:    "invalid YAML",
+			newDoc:  "header: [unclosed\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Diff([]byte(oldDoc), []byte(tt.newDoc))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var got []string
+			for _, change := range changes {
+				got = append(got, change.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config reads .baseline-init.yaml, which tailors the baseline that
+// check applies to an organization's own requirements
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the config file, looked for in the repository and
+// each of its parent directories
+const FileName = ".baseline-init.yaml"
+
+// Config customizes the checks of a repository. Files it does not mention
+// keep their default requirements.
+//
+//	files:
+//	  CODE_OF_CONDUCT.md:
+//	    required: true
+//	    priority: high
+type Config struct {
+	Files map[string]File `yaml:"files"`
+}
+
+// File overrides the check of one file, named as in the check results
+type File struct {
+	// Required, when set, decides whether the file's absence makes the
+	// repository non-compliant
+	Required *bool `yaml:"required"`
+	// Priority, when set, is the priority of the file's recommendations:
+	// critical, high, medium or low
+	Priority string `yaml:"priority"`
+}
+
+// Load reads and parses the config file at path
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Find returns the path of the config file closest to dir, looking in dir
+// and then in each parent directory, or "" if there is none
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+
+	for {
+		path := filepath.Join(dir, FileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
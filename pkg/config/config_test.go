@@ -0,0 +1,51 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfig_FindAndLoad(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "org", "repo")
+	if err := os.MkdirAll(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if path, err := Find(repo); err != nil || path != "" {
+		t.Fatalf("Find() = %q, %v; want no config", path, err)
+	}
+
+	content := "files:\n  CODE_OF_CONDUCT.md:\n    required: true\n    priority: high\n"
+	if err := os.WriteFile(filepath.Join(root, "org", FileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := Find(repo)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if want := filepath.Join(root, "org", FileName); path != want {
+		t.Fatalf("Find() = %q, want %q", path, want)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	file := cfg.Files["CODE_OF_CONDUCT.md"]
+	if file.Required == nil || !*file.Required || file.Priority != "high" {
+		t.Errorf("Load() = %+v, want CODE_OF_CONDUCT.md required with high priority", file)
+	}
+
+	if err := os.WriteFile(path, []byte("files:\n  LICENSE:\n    mandatory: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load() accepted an unknown field")
+	}
+}
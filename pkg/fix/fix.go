@@ -0,0 +1,216 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fix resolves common SECURITY-INSIGHTS.yml validation warnings: it
+// refreshes header.last-updated and header.last-reviewed and renews an
+// expired header.expiration-date. Edits are made on the original text, so
+// formatting, comments and fields the package does not touch are kept.
+package fix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Change describes one field rewritten by Fix. From is empty when the field
+// was added.
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+// String describes the change for a summary
+func (c Change) String() string {
+	if c.From == "" {
+		return fmt.Sprintf("%s: added %s", c.Field, c.To)
+	}
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.From, c.To)
+}
+
+// dateFields are the header fields refreshed to the current date, in the
+// order the specification lists them
+var dateFields = []string{"last-updated", "last-reviewed"}
+
+// edit replaces the text of one line, or inserts a new line after it when
+// insert is set
+type edit struct {
+	line   int // zero-based
+	insert bool
+	text   string
+}
+
+// Fix returns data with the header dates refreshed as of now, along with the
+// changes made. It fails rather than guess when the file cannot be parsed or
+// its header is not a block mapping.
+func Fix(data []byte, now time.Time) ([]byte, []Change, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("document is not a mapping")
+	}
+
+	_, header := lookup(doc.Content[0], "header")
+	if header == nil {
+		return nil, nil, fmt.Errorf("missing header section")
+	}
+	if header.Kind != yaml.MappingNode || header.Style&yaml.FlowStyle != 0 || len(header.Content) == 0 {
+		return nil, nil, fmt.Errorf("header must be a block mapping to be fixed")
+	}
+
+	// Schema 1.0.0 uses RFC 3339 timestamps; 2.0.0 uses plain dates
+	layout := "2006-01-02"
+	if _, version := lookup(header, "schema-version"); version != nil && strings.HasPrefix(version.Value, "1") {
+		layout = time.RFC3339
+	}
+	today := now.UTC().Format(layout)
+
+	lines := strings.SplitAfter(string(data), "\n")
+	var edits []edit
+	var changes []Change
+
+	// New fields go after the field that precedes them in spec order, or
+	// at the top of the header when none is present
+	after := header.Content[0].Line - 2
+	indent := strings.Repeat(" ", header.Content[0].Column-1)
+	if key, value := lookup(header, "schema-version"); key != nil && value.Line == key.Line {
+		after = key.Line - 1
+	}
+
+	for _, field := range dateFields {
+		key, value := lookup(header, field)
+		if key == nil {
+			edits = append(edits, edit{line: after, insert: true, text: fmt.Sprintf("%s%s: %s\n", indent, field, quoteDate(today, layout))})
+			changes = append(changes, Change{Field: "header." + field, To: today})
+			continue
+		}
+
+		after = key.Line - 1
+		if value.Value == today {
+			continue
+		}
+		text, err := replaceValue(lines[key.Line-1], key, value, today)
+		if err != nil {
+			return nil, nil, fmt.Errorf("header.%s: %w", field, err)
+		}
+		edits = append(edits, edit{line: key.Line - 1, text: text})
+		changes = append(changes, Change{Field: "header." + field, From: value.Value, To: today})
+	}
+
+	if key, value := lookup(header, "expiration-date"); key != nil {
+		expires, err := time.Parse(time.RFC3339, value.Value)
+		if err == nil && now.After(expires) {
+			renewed := now.UTC().AddDate(1, 0, 0).Format(time.RFC3339)
+			text, err := replaceValue(lines[key.Line-1], key, value, renewed)
+			if err != nil {
+				return nil, nil, fmt.Errorf("header.expiration-date: %w", err)
+			}
+			edits = append(edits, edit{line: key.Line - 1, text: text})
+			changes = append(changes, Change{Field: "header.expiration-date", From: value.Value, To: renewed})
+		}
+	}
+
+	fixed := []byte(apply(lines, edits))
+
+	var check yaml.Node
+	if err := yaml.Unmarshal(fixed, &check); err != nil {
+		return nil, nil, fmt.Errorf("fixed document is not valid YAML: %w", err)
+	}
+	return fixed, changes, nil
+}
+
+// lookup returns the key and value nodes for key in a mapping node
+func lookup(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// replaceValue rewrites the scalar following key on line, keeping its
+// quoting and any trailing comment
+func replaceValue(line string, key, value *yaml.Node, replacement string) (string, error) {
+	if value.Kind != yaml.ScalarNode || value.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return "", fmt.Errorf("not a single-line value")
+	}
+	if value.Value != "" && value.Line != key.Line {
+		return "", fmt.Errorf("value is not on the same line as its key")
+	}
+
+	colon := strings.Index(line[key.Column-1:], ":")
+	if colon < 0 {
+		return "", fmt.Errorf("cannot locate value")
+	}
+	start := key.Column - 1 + colon + 1
+	for start < len(line) && line[start] == ' ' {
+		start++
+	}
+
+	rest := line[start:]
+	end := 0
+	switch {
+	case value.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle) != 0:
+		closing := strings.IndexByte(rest[1:], rest[0])
+		if closing < 0 {
+			return "", fmt.Errorf("cannot locate value")
+		}
+		end = closing + 2
+		replacement = rest[:1] + replacement + rest[:1]
+	default:
+		end = len(strings.TrimRight(rest, "\r\n"))
+		if comment := strings.Index(rest, " #"); comment >= 0 {
+			end = comment
+		}
+		end = len(strings.TrimRight(rest[:end], " \t"))
+	}
+
+	prefix := line[:start]
+	if !strings.HasSuffix(prefix, " ") {
+		prefix += " "
+	}
+	return prefix + replacement + rest[end:], nil
+}
+
+// quoteDate formats a date for a newly added field, quoting plain dates so
+// they stay strings
+func quoteDate(value, layout string) string {
+	if layout == time.RFC3339 {
+		return value
+	}
+	return "'" + value + "'"
+}
+
+// apply performs edits on lines. Working bottom up keeps line numbers valid,
+// and lines inserted after the same line end up in the order they were added.
+func apply(lines []string, edits []edit) string {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].line < edits[j].line
+	})
+
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		switch {
+		case !e.insert:
+			lines[e.line] = e.text
+		case e.line < 0:
+			lines = append([]string{e.text}, lines...)
+		default:
+			if !strings.HasSuffix(lines[e.line], "\n") {
+				lines[e.line] += "\n"
+			}
+			lines = append(lines[:e.line+1], append([]string{e.text}, lines[e.line+1:]...)...)
+		}
+	}
+	return strings.Join(lines, "")
+}
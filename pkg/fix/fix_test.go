@@ -0,0 +1,154 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fix
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFix(t *testing.T) {
+	now := time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		wantChanges []string
+		wantErr     bool
+	}{
+		{
+			name: "stale dates",
+			input: `header:
+  schema-version: 2.0.0
+  last-updated: "2025-01-01" # bumped on release
+  last-reviewed: 2025-01-01
+  url: https://github.com/acme/widget
+x-custom: kept
+`,
+			want: `header:
+  schema-version: 2.0.0
+  last-updated: "2026-03-14" # bumped on release
+  last-reviewed: 2026-03-14
+  url: https://github.com/acme/widget
+x-custom: kept
+`,
+			wantChanges: []string{
+				"header.last-updated: 2025-01-01 -> 2026-03-14",
+				"header.last-reviewed: 2025-01-01 -> 2026-03-14",
+			},
+		},
+		{
+			name: "missing dates",
+			input: `# Security insights
+header:
+  schema-version: 2.0.0
+  url: https://github.com/acme/widget
+`,
+			want: `# Security insights
+header:
+  schema-version: 2.0.0
+  last-updated: '2026-03-14'
+  last-reviewed: '2026-03-14'
+  url: https://github.com/acme/widget
+`,
+			wantChanges: []string{
+				"header.last-updated: added 2026-03-14",
+				"header.last-reviewed: added 2026-03-14",
+			},
+		},
+		{
+			name: "only last-reviewed missing",
+			input: `header:
+  url: https://github.com/acme/widget
+  last-updated: '2026-03-14'
+  schema-version: 2.0.0
+`,
+			want: `header:
+  url: https://github.com/acme/widget
+  last-updated: '2026-03-14'
+  last-reviewed: '2026-03-14'
+  schema-version: 2.0.0
+`,
+			wantChanges: []string{"header.last-reviewed: added 2026-03-14"},
+		},
+		{
+			name: "expired v1 file",
+			input: `header:
+  schema-version: 1.0.0
+  expiration-date: '2025-06-01T00:00:00Z'
+  last-updated: 2025-01-01T00:00:00Z
+  last-reviewed: 2025-01-01T00:00:00Z
+  project-url: https://github.com/acme/widget
+`,
+			want: `header:
+  schema-version: 1.0.0
+  expiration-date: '2027-03-14T09:30:00Z'
+  last-updated: 2026-03-14T09:30:00Z
+  last-reviewed: 2026-03-14T09:30:00Z
+  project-url: https://github.com/acme/widget
+`,
+			wantChanges: []string{
+				"header.last-updated: 2025-01-01T00:00:00Z -> 2026-03-14T09:30:00Z",
+				"header.last-reviewed: 2025-01-01T00:00:00Z -> 2026-03-14T09:30:00Z",
+				"header.expiration-date: 2025-06-01T00:00:00Z -> 2027-03-14T09:30:00Z",
+			},
+		},
+		{
+			name: "nothing to fix",
+			input: `header:
+  schema-version: 1.0.0
+  expiration-date: 2027-01-01T00:00:00Z
+  last-updated: 2026-03-14T09:30:00Z
+  last-reviewed: 2026-03-14T09:30:00Z
+`,
+			want: `header:
+  schema-version: 1.0.0
+  expiration-date: 2027-01-01T00:00:00Z
+  last-updated: 2026-03-14T09:30:00Z
+  last-reviewed: 2026-03-14T09:30:00Z
+`,
+		},
+		{
+			name:    "invalid YAML",
+			input:   "header: [unclosed\n",
+			wantErr: true,
+		},
+		{
+			name:    "no header",
+			input:   "project:\n  name: widget\n",
+			wantErr: true,
+		},
+		{
+			name:    "flow header",
+			input:   "header: {schema-version: 2.0.0}\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changes, err := Fix([]byte(tt.input), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Fix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("Fix() output:\n%s\nwant:\n%s", got, tt.want)
+			}
+
+			summary := make([]string, len(changes))
+			for i, change := range changes {
+				summary[i] = change.String()
+			}
+			if strings.Join(summary, "\n") != strings.Join(tt.wantChanges, "\n") {
+				t.Errorf("changes = %q, want %q", summary, tt.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BatchFile lists repositories to set up in one run
+type BatchFile struct {
+	Repos []BatchEntry `yaml:"repos"`
+}
+
+// BatchEntry is a single repository in a batch file. Config holds overrides
+// that are applied on top of the auto mode defaults for that repository.
+type BatchEntry struct {
+	Path   string    `yaml:"path"`
+	Config yaml.Node `yaml:"config"`
+}
+
+// LoadBatch reads a batch file. Relative repository paths are resolved
+// against the directory containing the batch file. Unknown keys, including
+// in an entry's config, are rejected before any repository is set up, so a
+// mistyped key does not go unnoticed.
+func LoadBatch(path string) (*BatchFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch file: %w", err)
+	}
+
+	var batch BatchFile
+	if err := decodeStrict(data, &batch); err != nil {
+		return nil, fmt.Errorf("invalid batch file: %w", err)
+	}
+
+	if len(batch.Repos) == 0 {
+		return nil, fmt.Errorf("batch file %s lists no repos", path)
+	}
+
+	baseDir := filepath.Dir(path)
+	for i, entry := range batch.Repos {
+		if entry.Path == "" {
+			return nil, fmt.Errorf("repos[%d] is missing a path", i)
+		}
+		if err := entry.ApplyTo(&Config{}); err != nil {
+			return nil, err
+		}
+		if !filepath.IsAbs(entry.Path) {
+			batch.Repos[i].Path = filepath.Join(baseDir, entry.Path)
+		}
+	}
+
+	return &batch, nil
+}
+
+// ApplyTo overlays the entry's config overrides onto config
+func (e BatchEntry) ApplyTo(config *Config) error {
+	if e.Config.Kind == 0 {
+		return nil
+	}
+
+	// yaml.Node.Decode cannot reject unknown keys, so the node is decoded
+	// again from its encoded form
+	data, err := yaml.Marshal(&e.Config)
+	if err != nil {
+		return fmt.Errorf("invalid config for %s: %w", e.Path, err)
+	}
+	if err := decodeStrict(data, config); err != nil {
+		return fmt.Errorf("invalid config for %s: %w", e.Path, err)
+	}
+	return nil
+}
+
+// decodeStrict decodes YAML into v, failing on keys v has no field for
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
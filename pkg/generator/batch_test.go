@@ -0,0 +1,125 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantPaths []string
+		wantErr   string
+	}{
+		{
+			name:      "relative and absolute paths",
+			content:   "repos:\n  - path: api\n  - path: /srv/web\n",
+			wantPaths: []string{"api", "/srv/web"},
+		},
+		{
+			name:      "known config keys",
+			content:   "repos:\n  - path: api\n    config:\n      project-name: API\n      maintainers: [alice]\n",
+			wantPaths: []string{"api"},
+		},
+		{
+			name:    "unknown top-level key",
+			content: "repositories:\n  - path: api\n",
+			wantErr: "field repositories not found",
+		},
+		{
+			name:    "unknown entry key",
+			content: "repos:\n  - path: api\n    conifg:\n      project-name: API\n",
+			wantErr: "field conifg not found",
+		},
+		{
+			name:    "unknown config key",
+			content: "repos:\n  - path: api\n    config:\n      project-nmae: API\n",
+			wantErr: "field project-nmae not found",
+		},
+		{
+			name:    "malformed YAML",
+			content: "repos: [\n",
+			wantErr: "invalid batch file",
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantErr: "lists no repos",
+		},
+		{
+			name:    "missing path",
+			content: "repos:\n  - config:\n      project-name: API\n",
+			wantErr: "repos[0] is missing a path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "repos.yml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write batch file: %v", err)
+			}
+
+			batch, err := LoadBatch(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadBatch() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadBatch() error = %v", err)
+			}
+
+			if len(batch.Repos) != len(tt.wantPaths) {
+				t.Fatalf("LoadBatch() found %d repos, want %d", len(batch.Repos), len(tt.wantPaths))
+			}
+			for i, want := range tt.wantPaths {
+				if !filepath.IsAbs(want) {
+					want = filepath.Join(dir, want)
+				}
+				if batch.Repos[i].Path != want {
+					t.Errorf("repos[%d].Path = %s, want %s", i, batch.Repos[i].Path, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchEntry_ApplyTo(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "repos.yml")
+	content := "repos:\n  - path: api\n    config:\n      project-name: API\n  - path: web\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write batch file: %v", err)
+	}
+	batch, err := LoadBatch(path)
+	if err != nil {
+		t.Fatalf("LoadBatch() error = %v", err)
+	}
+
+	// Overrides replace only the keys they set
+	config := &Config{ProjectName: "default", License: "MIT"}
+	if err := batch.Repos[0].ApplyTo(config); err != nil {
+		t.Fatalf("ApplyTo() error = %v", err)
+	}
+	if config.ProjectName != "API" || config.License != "MIT" {
+		t.Errorf("ApplyTo() config = %+v, want project name API and license MIT", config)
+	}
+
+	// An entry without config leaves the defaults alone
+	config = &Config{ProjectName: "default"}
+	if err := batch.Repos[1].ApplyTo(config); err != nil {
+		t.Fatalf("ApplyTo() error = %v", err)
+	}
+	if config.ProjectName != "default" {
+		t.Errorf("ApplyTo() without config changed the project name to %q", config.ProjectName)
+	}
+}
@@ -5,34 +5,66 @@ package generator
 
 import (
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+	"github.com/aguamala/baseline-init/pkg/license"
+	"github.com/aguamala/baseline-init/pkg/validator"
 	"github.com/fatih/color"
 	"github.com/manifoldco/promptui"
+	"gopkg.in/yaml.v3"
 )
 
 // Generator handles creation of compliance files
 type Generator struct {
-	repoPath string
-	force    bool
+	repoPath  string
+	force     bool
+	skip      map[string]bool
+	generated []string
+	// noPrompt keeps existing files instead of asking whether to
+	// overwrite them
+	noPrompt bool
 }
 
 // Config contains configuration for file generation
 type Config struct {
-	ProjectURL              string
-	ProjectName             string
-	SecurityEmail           string
-	AcceptsVulnReports      bool
-	AcceptsPullRequests     bool
-	AcceptsAutomatedPR      bool
-	ProjectStage            string
-	BugFixesOnly            bool
-	Maintainers             []string
-	DistributionPoints      []string
+	ProjectURL          string   `json:"project_url" yaml:"project-url"`
+	ProjectName         string   `json:"project_name" yaml:"project-name"`
+	SecurityEmail       string   `json:"security_email" yaml:"security-email"`
+	AcceptsVulnReports  bool     `json:"accepts_vuln_reports" yaml:"accepts-vuln-reports"`
+	PrivateReporting    bool     `json:"private_reporting" yaml:"private-reporting"`
+	AcceptsPullRequests bool     `json:"accepts_pull_requests" yaml:"accepts-pull-requests"`
+	AcceptsAutomatedPR  bool     `json:"accepts_automated_pr" yaml:"accepts-automated-pr"`
+	ProjectStage        string   `json:"project_stage" yaml:"project-stage"`
+	BugFixesOnly        bool     `json:"bug_fixes_only" yaml:"bug-fixes-only"`
+	Maintainers         []string `json:"maintainers" yaml:"maintainers"`
+	Affiliation         string   `json:"affiliation" yaml:"affiliation"`
+	License             string   `json:"license" yaml:"license"`
+	DistributionPoints  []string `json:"distribution_points" yaml:"distribution-points"`
+	// LicenseURLStyle is "absolute" (the default when empty) for a full URL
+	// under ProjectURL, or "relative" for a path that resolves wherever the
+	// file is rendered, such as on a mirror
+	LicenseURLStyle string `json:"license_url_style,omitempty" yaml:"license-url-style,omitempty"`
+	// Branch is the default branch that absolute links to repository files
+	// point at. When empty they use HEAD, which GitHub resolves to the
+	// default branch.
+	Branch string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	// SupportedVersion is the release line SECURITY.md lists as receiving
+	// security fixes, such as "1.4.x". When empty the table gets a
+	// placeholder row for the maintainer to fill in.
+	SupportedVersion string `json:"supported_version,omitempty" yaml:"supported-version,omitempty"`
 }
 
+// ProjectStages are the lifecycle stages accepted for ProjectStage
+var ProjectStages = []string{"active", "archived", "concept", "moved", "wip"}
+
 // New creates a new Generator instance
 func New(repoPath string, force bool) *Generator {
 	return &Generator{
@@ -43,9 +75,19 @@ func New(repoPath string, force bool) *Generator {
 
 // GenerateDefaults generates files with default values
 func (g *Generator) GenerateDefaults() error {
-	config := &Config{
+	return g.GenerateWithConfig(g.DefaultConfig())
+}
+
+// DefaultConfig returns the configuration used by auto mode
+func (g *Generator) DefaultConfig() *Config {
+	projectName := filepath.Base(g.repoPath)
+	if absPath, err := filepath.Abs(g.repoPath); err == nil {
+		projectName = filepath.Base(absPath)
+	}
+
+	return &Config{
 		ProjectURL:              "https://github.com/example/repo",
-		ProjectName:             filepath.Base(g.repoPath),
+		ProjectName:             projectName,
 		SecurityEmail:           "security@example.com",
 		AcceptsVulnReports:      true,
 		AcceptsPullRequests:     true,
@@ -53,16 +95,178 @@ func (g *Generator) GenerateDefaults() error {
 		ProjectStage:            "active",
 		BugFixesOnly:            false,
 		Maintainers:             []string{"github:maintainer"},
+		License:                 DetectLicense(g.repoPath),
 		DistributionPoints:      []string{},
+		LicenseURLStyle:         "absolute",
+		Branch:                  defaultBranch(g.repoPath),
+		SupportedVersion:        DetectSupportedVersion(g.repoPath),
+	}
+}
+
+// defaultBranch returns the repository's default branch, or "" when it
+// cannot be detected
+func defaultBranch(repoPath string) string {
+	branch, err := gitutil.DefaultBranch(repoPath)
+	if err != nil {
+		return ""
+	}
+	return branch
+}
+
+// releaseTagPattern matches release tags such as v1.4.2 or 2.0, capturing
+// the major and minor version
+var releaseTagPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(\.\d+)?([-+].*)?$`)
+
+// DetectSupportedVersion returns the release line of the repository's latest
+// tag, such as "1.4.x" for v1.4.2, or "" when there is no release tag
+func DetectSupportedVersion(repoPath string) string {
+	tag, err := gitutil.LatestTag(repoPath)
+	if err != nil {
+		return ""
+	}
+	m := releaseTagPattern.FindStringSubmatch(tag)
+	if m == nil {
+		return ""
+	}
+	return m[1] + "." + m[2] + ".x"
+}
+
+// DefaultLicense is the license expression generated when a repository has
+// no LICENSE file to detect one from
+const DefaultLicense = "Apache-2.0"
+
+// DetectLicense returns the SPDX identifier of the license in repoPath's
+// LICENSE file, or DefaultLicense when there is none or it is not recognized
+func DetectLicense(repoPath string) string {
+	for _, name := range []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING"} {
+		data, err := os.ReadFile(filepath.Join(repoPath, name))
+		if err != nil {
+			continue
+		}
+		if id, ok := license.Detect(string(data)); ok {
+			return id
+		}
+		break
+	}
+	return DefaultLicense
+}
+
+// RepositoryURL composes the GitHub URL of owner/name, for scaffolding
+// repositories that have no git remote yet
+func RepositoryURL(owner, name string) (string, error) {
+	for _, part := range []string{owner, name} {
+		if part == "" || strings.ContainsAny(part, "/ ") {
+			return "", fmt.Errorf("invalid repository owner or name: %q", part)
+		}
+	}
+	return fmt.Sprintf("https://github.com/%s/%s", owner, name), nil
+}
+
+// FileURL returns the URL of file in the repository at config's Branch. It
+// is also used for project.vulnerability-reporting.security-policy, which
+// must be an absolute URL whatever the LicenseURLStyle.
+func FileURL(config *Config, file string) string {
+	branch := config.Branch
+	if branch == "" {
+		branch = "HEAD"
+	}
+	return config.ProjectURL + "/blob/" + branch + "/" + file
+}
+
+// LicenseURL returns the repository.license.url to generate for config's
+// LicenseURLStyle
+func LicenseURL(config *Config) (string, error) {
+	switch config.LicenseURLStyle {
+	case "", "absolute":
+		return FileURL(config, "LICENSE"), nil
+	case "relative":
+		return "LICENSE", nil
+	default:
+		return "", fmt.Errorf("invalid license URL style %q (expected absolute or relative)", config.LicenseURLStyle)
+	}
+}
+
+// Skip excludes the named files, such as "CODE_OF_CONDUCT.md", from
+// generation
+func (g *Generator) Skip(names ...string) {
+	if g.skip == nil {
+		g.skip = map[string]bool{}
+	}
+	for _, name := range names {
+		g.skip[name] = true
+	}
+}
+
+// SetNoPrompt makes the generator keep files that already exist, unless
+// force is set, rather than asking whether to overwrite them
+func (g *Generator) SetNoPrompt(noPrompt bool) {
+	g.noPrompt = noPrompt
+}
+
+// GeneratedFiles returns the paths of files written by this generator
+func (g *Generator) GeneratedFiles() []string {
+	return g.generated
+}
+
+// generatedFile is one file setup can generate, in the order they are
+// generated
+type generatedFile struct {
+	name string // path relative to the repository root
+	// check is the file of the checker.Checks entry this file satisfies;
+	// the file is not generated when any location of that check exists
+	check  string
+	render func(g *Generator, config *Config) ([]byte, error)
+}
+
+var generatedFiles = []generatedFile{
+	{"SECURITY-INSIGHTS.yml", "SECURITY-INSIGHTS.yml", (*Generator).generateSecurityInsights},
+	{"SECURITY.md", "SECURITY.md", (*Generator).generateSecurityMd},
+	{"GOVERNANCE.md", "GOVERNANCE.md", (*Generator).generateGovernanceMd},
+	{"CODE_OF_CONDUCT.md", "CODE_OF_CONDUCT.md", (*Generator).generateCodeOfConduct},
+	{"CONTRIBUTING.md", "CONTRIBUTING.md", (*Generator).generateContributing},
+	{".github/CODEOWNERS", "CODEOWNERS", (*Generator).generateCodeOwners},
+	{".github/dependabot.yml", "dependabot.yml", (*Generator).generateDependabot},
+}
+
+// existingElsewhere returns where the repository already holds a file that
+// the checks accept in place of the generated file name, at another path.
+// Generating it anyway would leave two copies to keep in sync.
+func (g *Generator) existingElsewhere(name string) (string, bool) {
+	for _, file := range generatedFiles {
+		if file.name != name || file.check == "" {
+			continue
+		}
+		if path, ok := checker.Locate(g.repoPath, file.check); ok && path != name {
+			return path, true
+		}
 	}
+	return "", false
+}
 
-	return g.GenerateWithConfig(config)
+// Render returns the content of each file that would be generated for
+// config, keyed by its path relative to the repository root. Skipped files
+// are left out. Nothing is written.
+func (g *Generator) Render(config *Config) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, file := range generatedFiles {
+		if g.skip[file.name] {
+			continue
+		}
+		content, err := file.render(g, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", file.name, err)
+		}
+		files[file.name] = content
+	}
+	return files, nil
 }
 
 // GenerateWithConfig generates files with provided configuration
 func (g *Generator) GenerateWithConfig(config *Config) error {
-	green := color.New(color.FgGreen).SprintFunc()
-	cyan := color.New(color.FgCyan).SprintFunc()
+	files, err := g.Render(config)
+	if err != nil {
+		return err
+	}
 
 	// Ensure .github directory exists
 	githubDir := filepath.Join(g.repoPath, ".github")
@@ -70,115 +274,174 @@ func (g *Generator) GenerateWithConfig(config *Config) error {
 		return fmt.Errorf("failed to create .github directory: %w", err)
 	}
 
-	// Generate SECURITY-INSIGHTS.yml
-	siPath := filepath.Join(g.repoPath, "SECURITY-INSIGHTS.yml")
-	if _, err := os.Stat(siPath); err == nil && !g.force {
-		action, err := g.promptForOverwrite("SECURITY-INSIGHTS.yml")
-		if err != nil {
+	for _, file := range generatedFiles {
+		content, ok := files[file.name]
+		if !ok {
+			continue
+		}
+		if err := g.writeFile(file.name, content); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		switch action {
-		case "skip":
-			fmt.Printf("%s Skipped SECURITY-INSIGHTS.yml\n", cyan("→"))
-		case "overwrite":
-			if err := g.generateSecurityInsights(siPath, config); err != nil {
-				return fmt.Errorf("failed to generate SECURITY-INSIGHTS.yml: %w", err)
-			}
-			fmt.Printf("%s Generated SECURITY-INSIGHTS.yml\n", green("✓"))
-		case "cancel":
-			return fmt.Errorf("setup cancelled by user")
+// DryRun writes the path and content of each file GenerateWithConfig would
+// write to w, noting files that already exist, without touching the
+// repository
+func (g *Generator) DryRun(config *Config, w io.Writer) error {
+	files, err := g.Render(config)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range generatedFiles {
+		content, ok := files[file.name]
+		if !ok {
+			continue
 		}
-	} else {
-		if err := g.generateSecurityInsights(siPath, config); err != nil {
-			return fmt.Errorf("failed to generate SECURITY-INSIGHTS.yml: %w", err)
+
+		path := filepath.Join(g.repoPath, file.name)
+		if existing, ok := g.existingElsewhere(file.name); ok {
+			fmt.Fprintf(w, "==> %s (skipped; %s exists)\n\n", path, existing)
+			continue
 		}
-		fmt.Printf("%s Generated SECURITY-INSIGHTS.yml\n", green("✓"))
+		note := ""
+		if _, err := os.Stat(path); err == nil {
+			note = " (exists; setup would ask before overwriting)"
+			if g.force {
+				note = " (exists; would be overwritten)"
+			}
+		}
+		fmt.Fprintf(w, "==> %s%s\n", path, note)
+		w.Write(content)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// writeFile writes one generated file, prompting before overwriting an
+// existing file unless force is set. A file already present at another
+// location the checks accept is skipped, even with force.
+func (g *Generator) writeFile(name string, content []byte) error {
+	green := color.New(color.FgGreen).SprintFunc()
+	cyan := color.New(color.FgCyan).SprintFunc()
+
+	if existing, ok := g.existingElsewhere(name); ok {
+		fmt.Printf("%s Skipped %s: %s exists\n", cyan("→"), name, existing)
+		return nil
 	}
 
-	// Generate SECURITY.md if it doesn't exist
-	securityMdPath := filepath.Join(g.repoPath, "SECURITY.md")
-	if _, err := os.Stat(securityMdPath); err == nil && !g.force {
-		action, err := g.promptForOverwrite("SECURITY.md")
+	path := filepath.Join(g.repoPath, name)
+	if _, err := os.Stat(path); err == nil && !g.force {
+		if g.noPrompt {
+			fmt.Printf("%s Skipped %s: it exists (use --force to overwrite)\n", cyan("→"), name)
+			return nil
+		}
+		action, err := g.promptForOverwrite(name)
 		if err != nil {
 			return err
 		}
 
 		switch action {
 		case "skip":
-			fmt.Printf("%s Skipped SECURITY.md\n", cyan("→"))
-		case "overwrite":
-			if err := g.generateSecurityMd(securityMdPath, config); err != nil {
-				return fmt.Errorf("failed to generate SECURITY.md: %w", err)
-			}
-			fmt.Printf("%s Generated SECURITY.md\n", green("✓"))
+			fmt.Printf("%s Skipped %s\n", cyan("→"), name)
+			return nil
 		case "cancel":
 			return fmt.Errorf("setup cancelled by user")
 		}
-	} else {
-		if err := g.generateSecurityMd(securityMdPath, config); err != nil {
-			return fmt.Errorf("failed to generate SECURITY.md: %w", err)
-		}
-		fmt.Printf("%s Generated SECURITY.md\n", green("✓"))
 	}
 
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	g.generated = append(g.generated, path)
+	fmt.Printf("%s Generated %s\n", green("✓"), name)
 	return nil
 }
 
-// generateSecurityInsights creates SECURITY-INSIGHTS.yml file
-func (g *Generator) generateSecurityInsights(path string, config *Config) error {
+// generateSecurityInsights renders SECURITY-INSIGHTS.yml
+func (g *Generator) generateSecurityInsights(config *Config) ([]byte, error) {
 	// Format dates as YYYY-MM-DD (schema 2.0.0 format)
-	lastUpdated := time.Now().Format("2006-01-02")
-	lastReviewed := time.Now().Format("2006-01-02")
-
-	// Format maintainers for the new schema
-	maintainersSection := formatMaintainersV2(config.Maintainers, config.SecurityEmail)
-
-	content := fmt.Sprintf(`# OpenSSF Security Insights
-# Schema version 2.0.0
-# For more information, see: https://github.com/ossf/security-insights-spec
-
-header:
-  schema-version: 2.0.0
-  last-updated: '%s'
-  last-reviewed: '%s'
-  url: %s
-  comment: |
-    This file provides security insights for the project.
-
-project:
-  name: %s
-  administrators:
-%s
-  vulnerability-reporting:
-    reports-accepted: %t
-    bug-bounty-available: false
-
-repository:
-  url: %s
-  status: %s
-  accepts-change-request: %t
-  accepts-automated-change-request: %t
-  core-team:
-%s
-  license:
-    url: %s/blob/main/LICENSE
-    expression: Apache-2.0
-  security:
-    assessments:
-      self:
-        comment: |
-          Self assessment has not yet been completed.
-`, lastUpdated, lastReviewed, config.ProjectURL, config.ProjectName,
-		maintainersSection, config.AcceptsVulnReports,
-		config.ProjectURL, config.ProjectStage, config.AcceptsPullRequests,
-		config.AcceptsAutomatedPR, maintainersSection, config.ProjectURL)
-
-	return os.WriteFile(path, []byte(content), 0644)
-}
-
-// generateSecurityMd creates SECURITY.md file
-func (g *Generator) generateSecurityMd(path string, config *Config) error {
+	today := time.Now().Format("2006-01-02")
+
+	licenseURL, err := LicenseURL(config)
+	if err != nil {
+		return nil, err
+	}
+
+	licenseExpression := config.License
+	if licenseExpression == "" {
+		licenseExpression = DefaultLicense
+	}
+
+	contacts := maintainerContacts(config.Maintainers, config.SecurityEmail, config.Affiliation)
+
+	doc := insightsDocument{
+		Header: insightsHeader{
+			SchemaVersion: "2.0.0",
+			LastUpdated:   today,
+			LastReviewed:  today,
+			URL:           config.ProjectURL,
+			Comment:       "This file provides security insights for the project.\n",
+		},
+		Project: insightsProject{
+			Name:           config.ProjectName,
+			Administrators: contacts,
+			VulnerabilityReporting: insightsVulnReporting{
+				ReportsAccepted: config.AcceptsVulnReports,
+				SecurityPolicy:  FileURL(config, "SECURITY.md"),
+			},
+		},
+		Repository: insightsRepository{
+			URL:                           config.ProjectURL,
+			Status:                        config.ProjectStage,
+			AcceptsChangeRequest:          config.AcceptsPullRequests,
+			AcceptsAutomatedChangeRequest: config.AcceptsAutomatedPR,
+			BugFixesOnly:                  config.BugFixesOnly,
+			CoreTeam:                      contacts,
+			License: insightsLicense{
+				URL:        licenseURL,
+				Expression: licenseExpression,
+			},
+			Release: insightsRelease{
+				DistributionPoints: distributionLinks(config.DistributionPoints),
+			},
+		},
+	}
+	if config.SecurityEmail != "" {
+		doc.Project.VulnerabilityReporting.Contact = &insightsContact{
+			Name:    "Security team",
+			Email:   config.SecurityEmail,
+			Primary: true,
+		}
+	}
+	doc.Repository.Security.Assessments.Self.Comment = "Self assessment has not yet been completed.\n"
+
+	var buf strings.Builder
+	buf.WriteString(insightsHeaderComment)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+
+	return []byte(buf.String()), nil
+}
+
+// generateSecurityMd renders SECURITY.md
+func (g *Generator) generateSecurityMd(config *Config) ([]byte, error) {
+	// Without a known release line, leave a row the maintainer must edit
+	// rather than claim a version the project may never have shipped
+	supported := fmt.Sprintf("| %-7s | :white_check_mark: |", config.SupportedVersion)
+	if config.SupportedVersion == "" {
+		supported = "<!-- Replace X.Y.x with the release lines that receive security fixes -->\n" +
+			"| X.Y.x   | :white_check_mark: |"
+	}
+
 	content := fmt.Sprintf(`# Security Policy
 
 ## Supported Versions
@@ -188,13 +451,12 @@ receiving such patches depends on the CVSS v3.0 Rating:
 
 | Version | Supported          |
 | ------- | ------------------ |
-| 1.0.x   | :white_check_mark: |
+%s
 
 ## Reporting a Vulnerability
 
-Please report security vulnerabilities to: %s
-
-We will acknowledge your email within 48 hours, and will send a more detailed response
+%s
+We will acknowledge your report within 48 hours, and will send a more detailed response
 within 7 days indicating the next steps in handling your report.
 
 After the initial reply to your report, we will endeavor to keep you informed of the
@@ -212,9 +474,353 @@ When we receive a security bug report, we will:
 
 If you have suggestions on how this process could be improved, please submit a pull
 request or open an issue.
+`, supported, reportingInstructions(config))
+
+	return []byte(content), nil
+}
+
+// reportingInstructions tells reporters where to send a vulnerability,
+// preferring GitHub private vulnerability reporting when it is enabled
+func reportingInstructions(config *Config) string {
+	if !config.PrivateReporting {
+		return fmt.Sprintf("Please report security vulnerabilities to: %s\n", config.SecurityEmail)
+	}
+	return fmt.Sprintf(`Please report security vulnerabilities privately through GitHub: open the
+repository's Security tab and choose "Report a vulnerability", or go directly to
+%s/security/advisories/new. The report stays private between you and the
+maintainers until an advisory is published.
+
+Please do not open public issues for security vulnerabilities. If you cannot use
+GitHub, email %s instead.
+`, config.ProjectURL, config.SecurityEmail)
+}
+
+// generateGovernanceMd renders GOVERNANCE.md
+func (g *Generator) generateGovernanceMd(config *Config) ([]byte, error) {
+	maintainers := ""
+	for _, spec := range config.Maintainers {
+		m := ParseMaintainer(spec)
+		maintainers += fmt.Sprintf("- [%s](%s)\n", m.Name(), m.SocialURL())
+	}
+	if maintainers == "" {
+		maintainers = "- (add maintainers here)\n"
+	}
+
+	content := fmt.Sprintf(`# %s Governance
+
+This document describes how %s is governed.
+
+## Roles
+
+### Maintainers
+
+Maintainers review and merge changes, cut releases, and triage security
+reports. The current maintainers are:
+
+%s
+### Contributors
+
+Anyone who opens an issue, submits a pull request, or reviews changes is a
+contributor. Contributors are expected to follow the project's code of conduct.
+
+## Decision Making
+
+Day-to-day decisions are made through pull request review: a change is merged
+once a maintainer approves it and no other maintainer objects.
+
+Significant changes (new features, breaking changes, governance changes) are
+proposed in an issue and remain open for at least one week. Maintainers aim for
+consensus; if none is reached, the change is decided by a simple majority of
+maintainers.
+
+## Becoming a Maintainer
+
+Contributors who have made sustained, high-quality contributions may be
+nominated by an existing maintainer. The nomination is discussed in an issue
+and accepted when a majority of the current maintainers agree.
+
+Maintainers who are no longer active may step down, or be moved to emeritus
+status by a majority of the other maintainers.
+
+## Security
+
+Security issues are handled as described in SECURITY.md. Private reports can be
+sent to %s.
+
+## Changes to this Document
+
+Changes to this governance document follow the process for significant changes
+described above.
+`, config.ProjectName, config.ProjectName, maintainers, config.SecurityEmail)
+
+	return []byte(content), nil
+}
+
+// generateCodeOfConduct renders CODE_OF_CONDUCT.md from the Contributor
+// Covenant 2.1, with the security email as the enforcement contact
+func (g *Generator) generateCodeOfConduct(config *Config) ([]byte, error) {
+	content := fmt.Sprintf(`# Contributor Covenant Code of Conduct
+
+## Our Pledge
+
+We as members, contributors, and leaders pledge to make participation in our
+community a harassment-free experience for everyone, regardless of age, body
+size, visible or invisible disability, ethnicity, sex characteristics, gender
+identity and expression, level of experience, education, socio-economic status,
+nationality, personal appearance, race, caste, color, religion, or sexual
+identity and orientation.
+
+We pledge to act and interact in ways that contribute to an open, welcoming,
+diverse, inclusive, and healthy community.
+
+## Our Standards
+
+Examples of behavior that contributes to a positive environment for our
+community include:
+
+* Demonstrating empathy and kindness toward other people
+* Being respectful of differing opinions, viewpoints, and experiences
+* Giving and gracefully accepting constructive feedback
+* Accepting responsibility and apologizing to those affected by our mistakes,
+  and learning from the experience
+* Focusing on what is best not just for us as individuals, but for the overall
+  community
+
+Examples of unacceptable behavior include:
+
+* The use of sexualized language or imagery, and sexual attention or advances of
+  any kind
+* Trolling, insulting or derogatory comments, and personal or political attacks
+* Public or private harassment
+* Publishing others' private information, such as a physical or email address,
+  without their explicit permission
+* Other conduct which could reasonably be considered inappropriate in a
+  professional setting
+
+## Enforcement Responsibilities
+
+Community leaders are responsible for clarifying and enforcing our standards of
+acceptable behavior and will take appropriate and fair corrective action in
+response to any behavior that they deem inappropriate, threatening, offensive,
+or harmful.
+
+Community leaders have the right and responsibility to remove, edit, or reject
+comments, commits, code, wiki edits, issues, and other contributions that are
+not aligned to this Code of Conduct, and will communicate reasons for moderation
+decisions when appropriate.
+
+## Scope
+
+This Code of Conduct applies within all community spaces, and also applies when
+an individual is officially representing the community in public spaces.
+Examples of representing our community include using an official e-mail address,
+posting via an official social media account, or acting as an appointed
+representative at an online or offline event.
+
+## Enforcement
+
+Instances of abusive, harassing, or otherwise unacceptable behavior may be
+reported to the community leaders responsible for enforcement at
+%s.
+All complaints will be reviewed and investigated promptly and fairly.
+
+All community leaders are obligated to respect the privacy and security of the
+reporter of any incident.
+
+## Enforcement Guidelines
+
+Community leaders will follow these Community Impact Guidelines in determining
+the consequences for any action they deem in violation of this Code of Conduct:
+
+### 1. Correction
+
+**Community Impact**: Use of inappropriate language or other behavior deemed
+unprofessional or unwelcome in the community.
+
+**Consequence**: A private, written warning from community leaders, providing
+clarity around the nature of the violation and an explanation of why the
+behavior was inappropriate. A public apology may be requested.
+
+### 2. Warning
+
+**Community Impact**: A violation through a single incident or series of
+actions.
+
+**Consequence**: A warning with consequences for continued behavior. No
+interaction with the people involved, including unsolicited interaction with
+those enforcing the Code of Conduct, for a specified period of time. This
+includes avoiding interactions in community spaces as well as external channels
+like social media. Violating these terms may lead to a temporary or permanent
+ban.
+
+### 3. Temporary Ban
+
+**Community Impact**: A serious violation of community standards, including
+sustained inappropriate behavior.
+
+**Consequence**: A temporary ban from any sort of interaction or public
+communication with the community for a specified period of time. No public or
+private interaction with the people involved, including unsolicited interaction
+with those enforcing the Code of Conduct, is allowed during this period.
+Violating these terms may lead to a permanent ban.
+
+### 4. Permanent Ban
+
+**Community Impact**: Demonstrating a pattern of violation of community
+standards, including sustained inappropriate behavior, harassment of an
+individual, or aggression toward or disparagement of classes of individuals.
+
+**Consequence**: A permanent ban from any sort of public interaction within the
+community.
+
+## Attribution
+
+This Code of Conduct is adapted from the [Contributor Covenant][homepage],
+version 2.1, available at
+[https://www.contributor-covenant.org/version/2/1/code_of_conduct.html][v2.1].
+
+Community Impact Guidelines were inspired by
+[Mozilla's code of conduct enforcement ladder][Mozilla CoC].
+
+For answers to common questions about this code of conduct, see the FAQ at
+[https://www.contributor-covenant.org/faq][FAQ]. Translations are available at
+[https://www.contributor-covenant.org/translations][translations].
+
+[homepage]: https://www.contributor-covenant.org
+[v2.1]: https://www.contributor-covenant.org/version/2/1/code_of_conduct.html
+[Mozilla CoC]: https://github.com/mozilla/diversity
+[FAQ]: https://www.contributor-covenant.org/faq
+[translations]: https://www.contributor-covenant.org/translations
 `, config.SecurityEmail)
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return []byte(content), nil
+}
+
+// generateContributing renders CONTRIBUTING.md, pointing security reports
+// at SECURITY.md rather than public issues
+func (g *Generator) generateContributing(config *Config) ([]byte, error) {
+	changes := `Pull requests are welcome. For anything larger than a small fix, please open
+an issue first so the approach can be discussed before you spend time on it.
+
+1. Fork the repository and create a branch from the default branch.
+2. Make your change, with tests where they apply.
+3. Make sure the existing tests pass.
+4. Open a pull request describing what the change does and why.
+`
+	if !config.AcceptsPullRequests {
+		changes = `This project does not accept pull requests. Please open an issue describing the
+change you would like to see instead.
+`
+	}
+	if config.BugFixesOnly {
+		changes += `
+The project is only accepting bug fixes at this time; new features will not be
+merged.
+`
+	}
+
+	content := fmt.Sprintf(`# Contributing to %s
+
+Thank you for your interest in contributing to %s! This document explains how
+to report problems and propose changes.
+
+## Code of Conduct
+
+This project follows the [Code of Conduct](CODE_OF_CONDUCT.md). By taking part
+you agree to uphold it.
+
+## Reporting Bugs
+
+Open an issue at %s/issues describing the problem, the steps to reproduce it,
+and what you expected to happen instead.
+
+## Reporting Security Issues
+
+Please do not report security vulnerabilities through public issues. Follow the
+process in [SECURITY.md](SECURITY.md) instead.
+
+## Proposing Changes
+
+%s
+## License
+
+By contributing, you agree that your contributions will be licensed under the
+project's license (%s).
+`, config.ProjectName, config.ProjectName, config.ProjectURL, changes, config.License)
+
+	return []byte(content), nil
+}
+
+// generateCodeOwners renders .github/CODEOWNERS, making the maintainers
+// owners of every file. GitHub reads the file from .github/ and only GitHub
+// handles can own code there, so other maintainers are left out; with none
+// left, the rule is a commented-out placeholder.
+func (g *Generator) generateCodeOwners(config *Config) ([]byte, error) {
+	var owners []string
+	for _, spec := range config.Maintainers {
+		if m := ParseMaintainer(spec); m.Platform == "github" && m.Handle != "" {
+			owners = append(owners, "@"+m.Handle)
+		}
+	}
+
+	rule := "* " + strings.Join(owners, " ")
+	if len(owners) == 0 {
+		rule = "# * @maintainer  (add code owners here)"
+	}
+
+	content := fmt.Sprintf(`# Code owners for %s
+#
+# Each line maps a path pattern to the people who review changes to it; the
+# last matching pattern wins. See
+# https://docs.github.com/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners
+
+%s
+`, config.ProjectName, rule)
+
+	return []byte(content), nil
+}
+
+// dependabotEcosystems are the package ecosystems generateDependabot
+// configures, each when its marker exists in the repository
+var dependabotEcosystems = []struct {
+	name   string
+	marker string
+}{
+	{"gomod", "go.mod"},
+	{"npm", "package.json"},
+	{"github-actions", filepath.Join(".github", "workflows")},
+}
+
+// generateDependabot renders .github/dependabot.yml with weekly version
+// updates for each ecosystem found in the repository, or for GitHub Actions
+// alone when none is
+func (g *Generator) generateDependabot(config *Config) ([]byte, error) {
+	var ecosystems []string
+	for _, ecosystem := range dependabotEcosystems {
+		if _, err := os.Stat(filepath.Join(g.repoPath, ecosystem.marker)); err == nil {
+			ecosystems = append(ecosystems, ecosystem.name)
+		}
+	}
+	if len(ecosystems) == 0 {
+		ecosystems = []string{"github-actions"}
+	}
+
+	updates := ""
+	for _, ecosystem := range ecosystems {
+		updates += fmt.Sprintf(`  - package-ecosystem: "%s"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+`, ecosystem)
+	}
+
+	content := fmt.Sprintf(`# Dependabot version updates for %s
+# https://docs.github.com/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file
+version: 2
+updates:
+%s`, config.ProjectName, updates)
+
+	return []byte(content), nil
 }
 
 // formatMaintainersList formats maintainers for YAML (legacy 1.0.0 format)
@@ -230,50 +836,63 @@ func formatMaintainersList(maintainers []string) string {
 	return result[:len(result)-1] // Remove trailing newline
 }
 
-// formatMaintainersV2 formats maintainers for schema 2.0.0
-func formatMaintainersV2(maintainers []string, email string) string {
+// maintainerContacts builds the schema 2.0.0 contact list used for both
+// project.administrators and repository.core-team. Maintainers naming the
+// same profile are listed once, and only the primary contact carries the
+// security email so that the entries don't share one address.
+func maintainerContacts(maintainers []string, email, affiliation string) []insightsContact {
+	if affiliation == "" {
+		affiliation = validator.PlaceholderAffiliation
+	}
+
 	if len(maintainers) == 0 {
-		return `    - name: Maintainer
-      affiliation: Organization
-      email: ` + email + `
-      social: https://github.com/maintainer
-      primary: true`
+		return []insightsContact{{
+			Name:        "Maintainer",
+			Affiliation: affiliation,
+			Email:       email,
+			Social:      "https://github.com/maintainer",
+			Primary:     true,
+		}}
 	}
 
-	result := ""
-	for i, m := range maintainers {
-		// Extract username from github:username format
-		username := m
-		if len(m) > 7 && m[:7] == "github:" {
-			username = m[7:]
+	var contacts []insightsContact
+	seen := map[string]bool{}
+	for _, spec := range maintainers {
+		m := ParseMaintainer(spec)
+		key := strings.ToLower(m.SocialURL())
+		if seen[key] {
+			continue
 		}
+		seen[key] = true
 
-		primary := "false"
-		if i == 0 {
-			primary = "true"
+		contact := insightsContact{
+			Name:        m.Name(),
+			Affiliation: affiliation,
+			Social:      m.SocialURL(),
 		}
-
-		result += fmt.Sprintf(`    - name: %s
-      affiliation: Organization
-      email: %s
-      social: https://github.com/%s
-      primary: %s
-`, username, email, username, primary)
+		if len(contacts) == 0 {
+			contact.Email = email
+			contact.Primary = true
+		}
+		contacts = append(contacts, contact)
 	}
-	return result[:len(result)-1] // Remove trailing newline
+	return contacts
 }
 
-// formatDistributionPoints formats distribution points for YAML
-func formatDistributionPoints(points []string) string {
-	if len(points) == 0 {
-		return "  - https://github.com/example/repo/releases"
-	}
-
-	result := ""
+// distributionLinks converts distribution points for repository.release,
+// as an empty list when there are none. A point without a scheme, such as
+// the registry reference ghcr.io/acme/widget, gets https:// so that it is the
+// absolute URL the validator requires; package URLs such as pkg:npm/widget
+// keep their scheme.
+func distributionLinks(points []string) []insightsLink {
+	links := []insightsLink{}
 	for _, p := range points {
-		result += fmt.Sprintf("  - %s\n", p)
+		if u, err := url.Parse(p); err == nil && u.Scheme == "" {
+			p = "https://" + strings.TrimPrefix(p, "//")
+		}
+		links = append(links, insightsLink{URI: p})
 	}
-	return result[:len(result)-1] // Remove trailing newline
+	return links
 }
 
 // promptForOverwrite prompts user for action when file exists
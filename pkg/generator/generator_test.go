@@ -0,0 +1,1122 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/license"
+	"github.com/aguamala/baseline-init/pkg/validator"
+	sitooling "github.com/ossf/si-tooling/v2/si"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerator_SecurityInsightsMatchesSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func(g *Generator) *Config
+	}{
+		{
+			name: "defaults",
+			config: func(g *Generator) *Config {
+				return g.DefaultConfig()
+			},
+		},
+		{
+			name: "several maintainers",
+			config: func(g *Generator) *Config {
+				config := g.DefaultConfig()
+				config.ProjectURL = "https://github.com/acme/widget"
+				config.Maintainers = []string{"github:alice", "gitlab:bob", "mastodon:@carol@example.social"}
+				config.ProjectStage = "inactive"
+				return config
+			},
+		},
+		{
+			name: "no maintainers",
+			config: func(g *Generator) *Config {
+				config := g.DefaultConfig()
+				config.Maintainers = nil
+				return config
+			},
+		},
+		{
+			name: "relative license URL",
+			config: func(g *Generator) *Config {
+				config := g.DefaultConfig()
+				config.LicenseURLStyle = "relative"
+				return config
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			g := New(dir, true)
+			config := tt.config(g)
+
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, "SECURITY-INSIGHTS.yml"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			// Strict decoding fails on any key si-tooling does not know about
+			var insights sitooling.SecurityInsights
+			decoder := yaml.NewDecoder(bytes.NewReader(data))
+			decoder.KnownFields(true)
+			if err := decoder.Decode(&insights); err != nil {
+				t.Fatalf("generated file does not match si-tooling schema: %v", err)
+			}
+
+			required := map[string]string{
+				"header.schema-version":         insights.Header.SchemaVersion,
+				"header.last-updated":           insights.Header.LastUpdated,
+				"header.last-reviewed":          insights.Header.LastReviewed,
+				"header.url":                    insights.Header.URL,
+				"project.name":                  insights.Project.Name,
+				"repository.url":                insights.Repository.URL,
+				"repository.status":             insights.Repository.Status,
+				"repository.license.url":        insights.Repository.License.URL,
+				"repository.license.expression": insights.Repository.License.Expression,
+			}
+			for field, value := range required {
+				if value == "" {
+					t.Errorf("required field %s is missing", field)
+				}
+			}
+
+			if len(insights.Project.Administrators) == 0 {
+				t.Error("required field project.administrators is empty")
+			}
+			if len(insights.Repository.CoreTeam) == 0 {
+				t.Error("required field repository.core-team is empty")
+			}
+
+			// Values from the config must survive the round trip
+			if insights.Project.Name != config.ProjectName {
+				t.Errorf("project.name = %q, want %q", insights.Project.Name, config.ProjectName)
+			}
+			if insights.Repository.URL != config.ProjectURL {
+				t.Errorf("repository.url = %q, want %q", insights.Repository.URL, config.ProjectURL)
+			}
+			if insights.Repository.Status != config.ProjectStage {
+				t.Errorf("repository.status = %q, want %q", insights.Repository.Status, config.ProjectStage)
+			}
+			if insights.Project.Vulnerability.ReportsAccepted != config.AcceptsVulnReports {
+				t.Errorf("vulnerability-reporting.reports-accepted = %t, want %t",
+					insights.Project.Vulnerability.ReportsAccepted, config.AcceptsVulnReports)
+			}
+			if insights.Repository.AcceptsChangeRequest != config.AcceptsPullRequests {
+				t.Errorf("repository.accepts-change-request = %t, want %t",
+					insights.Repository.AcceptsChangeRequest, config.AcceptsPullRequests)
+			}
+			if insights.Repository.AcceptsAutomatedChangeRequest != config.AcceptsAutomatedPR {
+				t.Errorf("repository.accepts-automated-change-request = %t, want %t",
+					insights.Repository.AcceptsAutomatedChangeRequest, config.AcceptsAutomatedPR)
+			}
+			if len(config.Maintainers) > 0 && len(insights.Repository.CoreTeam) != len(config.Maintainers) {
+				t.Errorf("repository.core-team has %d entries, want %d",
+					len(insights.Repository.CoreTeam), len(config.Maintainers))
+			}
+			if len(config.Maintainers) > 0 && len(insights.Project.Administrators) != len(config.Maintainers) {
+				t.Errorf("project.administrators has %d entries, want %d",
+					len(insights.Project.Administrators), len(config.Maintainers))
+			}
+			if want, _ := LicenseURL(config); insights.Repository.License.URL != want {
+				t.Errorf("repository.license.url = %q, want %q", insights.Repository.License.URL, want)
+			}
+		})
+	}
+}
+
+func TestGenerator_RenderValidates(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func(config *Config)
+		// wantPolicy is the expected security-policy URL, when checked
+		wantPolicy string
+	}{
+		{name: "defaults", config: func(config *Config) {}},
+		{name: "default branch", config: func(config *Config) {
+			config.Branch = "trunk"
+		}, wantPolicy: "https://github.com/example/repo/blob/trunk/SECURITY.md"},
+		{name: "unknown branch", config: func(config *Config) {
+			config.Branch = ""
+		}, wantPolicy: "https://github.com/example/repo/blob/HEAD/SECURITY.md"},
+		{name: "custom project", config: func(config *Config) {
+			config.ProjectURL = "https://gitlab.com/acme/widget"
+			config.ProjectName = "Widget: the \"original\""
+			config.SecurityEmail = "security@acme.com"
+			config.Maintainers = []string{"github:alice", "gitlab:bob", "mastodon:@carol@example.social"}
+			config.Affiliation = "Acme"
+			config.ProjectStage = "wip"
+			config.DistributionPoints = []string{"https://pkg.go.dev/gitlab.com/acme/widget"}
+		}},
+		{name: "closed to contributions", config: func(config *Config) {
+			config.AcceptsPullRequests = false
+			config.AcceptsAutomatedPR = false
+			config.BugFixesOnly = true
+			config.PrivateReporting = true
+		}},
+		{name: "relative license URL", config: func(config *Config) {
+			config.LicenseURLStyle = "relative"
+			config.Branch = "main"
+		}, wantPolicy: "https://github.com/example/repo/blob/main/SECURITY.md"},
+		{name: "registry distribution point", config: func(config *Config) {
+			config.DistributionPoints = []string{"ghcr.io/acme/widget"}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New(t.TempDir(), false)
+			config := g.DefaultConfig()
+			tt.config(config)
+
+			files, err := g.Render(config)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			result, err := validator.New().ValidateSecurityInsights(files["SECURITY-INSIGHTS.yml"])
+			if err != nil {
+				t.Fatalf("ValidateSecurityInsights() error = %v", err)
+			}
+			if !result.IsValid || len(result.Errors) > 0 {
+				t.Errorf("rendered SECURITY-INSIGHTS.yml is invalid: %v\n%s", result.Errors, files["SECURITY-INSIGHTS.yml"])
+			}
+			if result.SchemaVersion != validator.LatestSchemaVersion || result.SchemaInferred {
+				t.Errorf("validated as schema %s (inferred %v), want %s",
+					result.SchemaVersion, result.SchemaInferred, validator.LatestSchemaVersion)
+			}
+			// Fields the generator has values for should not be reported missing
+			for _, warning := range result.Warnings {
+				if strings.HasPrefix(warning, "Missing") {
+					t.Errorf("unexpected warning: %s", warning)
+				}
+			}
+
+			if tt.wantPolicy != "" {
+				var insights sitooling.SecurityInsights
+				if err := yaml.Unmarshal(files["SECURITY-INSIGHTS.yml"], &insights); err != nil {
+					t.Fatalf("rendered file is not valid YAML: %v", err)
+				}
+				if got := insights.Project.Vulnerability.SecurityPolicy; got != tt.wantPolicy {
+					t.Errorf("security-policy = %q, want %q", got, tt.wantPolicy)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_RepositoryURL(t *testing.T) {
+	tests := []struct {
+		owner, name string
+		want        string
+		wantErr     bool
+	}{
+		{"acme", "widget", "https://github.com/acme/widget", false},
+		{"", "widget", "", true},
+		{"acme", "", "", true},
+		{"acme/widget", "x", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := RepositoryURL(tt.owner, tt.name)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("RepositoryURL(%q, %q) = %q, %v; want %q, wantErr %v", tt.owner, tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestGenerator_LicenseURL(t *testing.T) {
+	tests := []struct {
+		style   string
+		branch  string
+		want    string
+		wantErr bool
+	}{
+		{"", "main", "https://github.com/acme/widget/blob/main/LICENSE", false},
+		{"absolute", "main", "https://github.com/acme/widget/blob/main/LICENSE", false},
+		{"absolute", "trunk", "https://github.com/acme/widget/blob/trunk/LICENSE", false},
+		{"absolute", "", "https://github.com/acme/widget/blob/HEAD/LICENSE", false},
+		{"relative", "trunk", "LICENSE", false},
+		{"Relative", "main", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style+"/"+tt.branch, func(t *testing.T) {
+			config := &Config{ProjectURL: "https://github.com/acme/widget", LicenseURLStyle: tt.style, Branch: tt.branch}
+			got, err := LicenseURL(config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LicenseURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LicenseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_ApplyOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []string
+		check     func(t *testing.T, config *Config)
+		wantErr   bool
+	}{
+		{
+			name:      "string by field name",
+			overrides: []string{"SecurityEmail=sec@acme.com"},
+			check: func(t *testing.T, config *Config) {
+				if config.SecurityEmail != "sec@acme.com" {
+					t.Errorf("SecurityEmail = %q, want %q", config.SecurityEmail, "sec@acme.com")
+				}
+			},
+		},
+		{
+			name:      "yaml name and value containing equals",
+			overrides: []string{"project-url=https://example.com/?a=b"},
+			check: func(t *testing.T, config *Config) {
+				if config.ProjectURL != "https://example.com/?a=b" {
+					t.Errorf("ProjectURL = %q", config.ProjectURL)
+				}
+			},
+		},
+		{
+			name:      "bool coercion",
+			overrides: []string{"BugFixesOnly=true", "acceptsautomatedpr=0"},
+			check: func(t *testing.T, config *Config) {
+				if !config.BugFixesOnly {
+					t.Error("BugFixesOnly = false, want true")
+				}
+				if config.AcceptsAutomatedPR {
+					t.Error("AcceptsAutomatedPR = true, want false")
+				}
+			},
+		},
+		{
+			name:      "string slice",
+			overrides: []string{"Maintainers=github:alice, github:bob"},
+			check: func(t *testing.T, config *Config) {
+				if len(config.Maintainers) != 2 || config.Maintainers[1] != "github:bob" {
+					t.Errorf("Maintainers = %v", config.Maintainers)
+				}
+			},
+		},
+		{
+			name:      "later override wins",
+			overrides: []string{"ProjectStage=wip", "ProjectStage=active"},
+			check: func(t *testing.T, config *Config) {
+				if config.ProjectStage != "active" {
+					t.Errorf("ProjectStage = %q, want %q", config.ProjectStage, "active")
+				}
+			},
+		},
+		{
+			name:      "unknown key",
+			overrides: []string{"Nope=1"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid bool",
+			overrides: []string{"BugFixesOnly=sometimes"},
+			wantErr:   true,
+		},
+		{
+			name:      "missing equals",
+			overrides: []string{"SecurityEmail"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := New(t.TempDir(), false).DefaultConfig()
+			err := ApplyOverrides(config, tt.overrides)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyOverrides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, config)
+			}
+		})
+	}
+}
+
+func TestGenerator_ParseMaintainer(t *testing.T) {
+	tests := []struct {
+		spec       string
+		wantName   string
+		wantSocial string
+		wantString string
+	}{
+		{"github:alice", "alice", "https://github.com/alice", "github:alice"},
+		{"alice", "alice", "https://github.com/alice", "github:alice"},
+		{"gitlab:bob", "bob", "https://gitlab.com/bob", "gitlab:bob"},
+		{"mastodon:@carol@example.social", "carol", "https://example.social/@carol", "mastodon:@carol@example.social"},
+		{"Mastodon:carol@example.social", "carol", "https://example.social/@carol", "mastodon:@carol@example.social"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			m := ParseMaintainer(tt.spec)
+			if got := m.Name(); got != tt.wantName {
+				t.Errorf("Name() = %q, want %q", got, tt.wantName)
+			}
+			if got := m.SocialURL(); got != tt.wantSocial {
+				t.Errorf("SocialURL() = %q, want %q", got, tt.wantSocial)
+			}
+			if got := m.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestGenerator_GovernanceMd(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	config := g.DefaultConfig()
+	config.Maintainers = []string{"github:alice", "gitlab:bob"}
+
+	if err := g.GenerateWithConfig(config); err != nil {
+		t.Fatalf("GenerateWithConfig() error = %v", err)
+	}
+
+	path := filepath.Join(dir, "GOVERNANCE.md")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	for _, want := range []string{config.ProjectName, "https://github.com/alice", "https://gitlab.com/bob", config.SecurityEmail} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("GOVERNANCE.md does not mention %q", want)
+		}
+	}
+
+	result, err := validator.New().ValidateFile(path)
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+	if !result.IsValid || len(result.Warnings) > 0 {
+		t.Errorf("generated GOVERNANCE.md does not pass validation: errors %v, warnings %v", result.Errors, result.Warnings)
+	}
+}
+
+func TestGenerator_CommunityFiles(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	config := g.DefaultConfig()
+	config.SecurityEmail = "conduct@example.org"
+
+	if err := g.GenerateWithConfig(config); err != nil {
+		t.Fatalf("GenerateWithConfig() error = %v", err)
+	}
+
+	files := map[string][]string{
+		"CODE_OF_CONDUCT.md": {"Contributor Covenant", "version 2.1", "conduct@example.org"},
+		"CONTRIBUTING.md":    {config.ProjectName, "CODE_OF_CONDUCT.md", "SECURITY.md", config.License},
+	}
+	for name, wants := range files {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("%s was not generated: %v", name, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s does not mention %q", name, want)
+			}
+		}
+	}
+}
+
+func TestGenerator_ExistingElsewhere(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string // path of a file the checks accept
+		skipped  string // generated file that should be left out
+	}{
+		{"policy in docs", "docs/SECURITY.md", "SECURITY.md"},
+		{"insights in .github", ".github/SECURITY-INSIGHTS.yaml", "SECURITY-INSIGHTS.yml"},
+		{"contributing in .github", ".github/CONTRIBUTING.md", "CONTRIBUTING.md"},
+		{"codeowners at the root", "CODEOWNERS", ".github/CODEOWNERS"},
+		{"codeowners in docs", "docs/CODEOWNERS", ".github/CODEOWNERS"},
+		{"renovate instead of dependabot", "renovate.json", ".github/dependabot.yml"},
+		{"dependabot.yaml", ".github/dependabot.yaml", ".github/dependabot.yml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			existing := filepath.Join(dir, filepath.FromSlash(tt.existing))
+			if err := os.MkdirAll(filepath.Dir(existing), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(existing, []byte("kept\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			g := New(dir, true)
+			var out bytes.Buffer
+			if err := g.DryRun(g.DefaultConfig(), &out); err != nil {
+				t.Fatalf("DryRun() error = %v", err)
+			}
+			want := filepath.Join(dir, tt.skipped) + " (skipped; " + tt.existing + " exists)"
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("DryRun() output does not contain %q", want)
+			}
+
+			if err := g.GenerateDefaults(); err != nil {
+				t.Fatalf("GenerateDefaults() error = %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, tt.skipped)); !os.IsNotExist(err) {
+				t.Errorf("%s was generated alongside %s", tt.skipped, tt.existing)
+			}
+			if data, _ := os.ReadFile(existing); string(data) != "kept\n" {
+				t.Errorf("%s was modified: %q", tt.existing, data)
+			}
+		})
+	}
+}
+
+func TestGenerator_CodeOwners(t *testing.T) {
+	g := New(t.TempDir(), true)
+	config := g.DefaultConfig()
+
+	config.Maintainers = []string{"github:alice", "@bob", "gitlab:carol", "mastodon:dave@example.social"}
+	content, err := g.generateCodeOwners(config)
+	if err != nil {
+		t.Fatalf("generateCodeOwners() error = %v", err)
+	}
+	if !strings.Contains(string(content), "\n* @alice @bob\n") {
+		t.Errorf("CODEOWNERS does not assign * to the GitHub maintainers:\n%s", content)
+	}
+	for _, other := range []string{"carol", "dave"} {
+		if strings.Contains(string(content), other) {
+			t.Errorf("CODEOWNERS lists %s, who has no GitHub handle:\n%s", other, content)
+		}
+	}
+
+	// Without GitHub handles only the placeholder is left
+	for _, maintainers := range [][]string{nil, {"gitlab:carol", "mastodon:dave@example.social"}} {
+		config.Maintainers = maintainers
+		content, err = g.generateCodeOwners(config)
+		if err != nil {
+			t.Fatalf("generateCodeOwners() error = %v", err)
+		}
+		if !strings.Contains(string(content), "# * @maintainer") {
+			t.Errorf("CODEOWNERS for %v has no placeholder:\n%s", maintainers, content)
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			if strings.HasPrefix(line, "*") {
+				t.Errorf("CODEOWNERS for %v assigns owners: %q", maintainers, line)
+			}
+		}
+	}
+}
+
+func TestGenerator_Dependabot(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	config := g.DefaultConfig()
+
+	content, err := g.generateDependabot(config)
+	if err != nil {
+		t.Fatalf("generateDependabot() error = %v", err)
+	}
+	if !strings.Contains(string(content), `package-ecosystem: "github-actions"`) {
+		t.Errorf("dependabot.yml without markers does not update GitHub Actions:\n%s", content)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/repo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte("{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	content, err = g.generateDependabot(config)
+	if err != nil {
+		t.Fatalf("generateDependabot() error = %v", err)
+	}
+	var parsed struct {
+		Version int `yaml:"version"`
+		Updates []struct {
+			Ecosystem string `yaml:"package-ecosystem"`
+		} `yaml:"updates"`
+	}
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		t.Fatalf("dependabot.yml is not valid YAML: %v", err)
+	}
+	if parsed.Version != 2 || len(parsed.Updates) != 2 || parsed.Updates[0].Ecosystem != "gomod" || parsed.Updates[1].Ecosystem != "npm" {
+		t.Errorf("dependabot.yml = %+v, want version 2 with gomod and npm updates", parsed)
+	}
+}
+
+func TestGenerator_Skip(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	g.Skip("CODE_OF_CONDUCT.md", "CONTRIBUTING.md")
+
+	if err := g.GenerateDefaults(); err != nil {
+		t.Fatalf("GenerateDefaults() error = %v", err)
+	}
+
+	for _, name := range []string{"CODE_OF_CONDUCT.md", "CONTRIBUTING.md"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s was generated despite Skip", name)
+		}
+	}
+	if len(g.GeneratedFiles()) != 5 {
+		t.Errorf("GeneratedFiles() = %v, want the three security and governance files, CODEOWNERS and dependabot.yml", g.GeneratedFiles())
+	}
+}
+
+func TestGenerator_NoPrompt(t *testing.T) {
+	tests := []struct {
+		name     string
+		force    bool
+		wantKept bool
+	}{
+		{"existing file kept", false, true},
+		{"force overwrites", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "SECURITY.md")
+			if err := os.WriteFile(path, []byte("custom policy\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			g := New(dir, tt.force)
+			g.SetNoPrompt(true)
+			if err := g.GenerateDefaults(); err != nil {
+				t.Fatalf("GenerateDefaults() error = %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kept := string(data) == "custom policy\n"; kept != tt.wantKept {
+				t.Errorf("SECURITY.md kept = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestGenerator_DryRun(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "SECURITY.md")
+	if err := os.WriteFile(existing, []byte("# Old policy\n"), 0644); err != nil {
+		t.Fatalf("Failed to write SECURITY.md: %v", err)
+	}
+
+	g := New(dir, true)
+	g.Skip("CODE_OF_CONDUCT.md")
+	config := g.DefaultConfig()
+
+	files, err := g.Render(config)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(files) != 6 || !bytes.Contains(files["SECURITY.md"], []byte(config.SecurityEmail)) {
+		t.Errorf("Render() files = %d, SECURITY.md = %q", len(files), files["SECURITY.md"])
+	}
+
+	var out bytes.Buffer
+	if err := g.DryRun(config, &out); err != nil {
+		t.Fatalf("DryRun() error = %v", err)
+	}
+	for _, want := range []string{
+		"==> " + filepath.Join(dir, "SECURITY-INSIGHTS.yml") + "\n",
+		"==> " + existing + " (exists; would be overwritten)\n",
+		"schema-version: 2.0.0",
+		string(files["CONTRIBUTING.md"]),
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("DryRun() output does not contain %q", want)
+		}
+	}
+	if strings.Contains(out.String(), "==> "+filepath.Join(dir, "CODE_OF_CONDUCT.md")) {
+		t.Errorf("DryRun() output lists a skipped file")
+	}
+
+	// Nothing is written: the existing file is untouched and no others appear
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("dry run created files: %v", entries)
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "# Old policy\n" {
+		t.Errorf("dry run changed SECURITY.md: %q", data)
+	}
+	if len(g.GeneratedFiles()) != 0 {
+		t.Errorf("GeneratedFiles() = %v after a dry run", g.GeneratedFiles())
+	}
+}
+
+func TestGenerator_SecurityMdReporting(t *testing.T) {
+	tests := []struct {
+		name             string
+		privateReporting bool
+		want             []string
+	}{
+		{"email", false, []string{"report security vulnerabilities to: security@example.com"}},
+		{"private reporting", true, []string{
+			"https://github.com/acme/widget/security/advisories/new",
+			"email security@example.com instead",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			g := New(dir, true)
+			config := g.DefaultConfig()
+			config.ProjectURL = "https://github.com/acme/widget"
+			config.PrivateReporting = tt.privateReporting
+
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, "SECURITY.md"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("SECURITY.md does not contain %q:\n%s", want, data)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_SecurityMdSupportedVersions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	// repo returns a git repository with the given tags on its only commit
+	repo := func(t *testing.T, tags ...string) string {
+		t.Helper()
+		dir := t.TempDir()
+		steps := [][]string{
+			{"init", "-q"},
+			{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+		}
+		for _, tag := range tags {
+			steps = append(steps, []string{"tag", tag})
+		}
+		for _, args := range steps {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("git %v failed: %v (%s)", args, err, out)
+			}
+		}
+		return dir
+	}
+
+	tests := []struct {
+		name      string
+		dir       string
+		supported string
+		wantRow   string
+	}{
+		{"release tag", repo(t, "v1.4.2"), "", "| 1.4.x   | :white_check_mark: |"},
+		{"tag without v", repo(t, "2.0"), "", "| 2.0.x   | :white_check_mark: |"},
+		{"configured", repo(t, "v1.4.2"), "3.1.x", "| 3.1.x   | :white_check_mark: |"},
+		{"no tags", repo(t), "", "| X.Y.x   | :white_check_mark: |"},
+		{"not a release tag", repo(t, "nightly"), "", "| X.Y.x   | :white_check_mark: |"},
+		{"outside git", t.TempDir(), "", "| X.Y.x   | :white_check_mark: |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New(tt.dir, true)
+			config := g.DefaultConfig()
+			if tt.supported != "" {
+				config.SupportedVersion = tt.supported
+			}
+
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(tt.dir, "SECURITY.md"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			if !strings.Contains(string(data), tt.wantRow) {
+				t.Errorf("SECURITY.md does not contain %q:\n%s", tt.wantRow, data)
+			}
+			if strings.Contains(string(data), "1.0.x") {
+				t.Errorf("SECURITY.md still lists 1.0.x:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestGenerator_Affiliation(t *testing.T) {
+	tests := []struct {
+		affiliation string
+		want        string
+	}{
+		{"", validator.PlaceholderAffiliation},
+		{"Acme Corp", "Acme Corp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			dir := t.TempDir()
+			g := New(dir, true)
+			config := g.DefaultConfig()
+			config.Maintainers = []string{"github:alice", "github:bob"}
+			config.Affiliation = tt.affiliation
+
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, "SECURITY-INSIGHTS.yml"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			var insights sitooling.SecurityInsights
+			if err := yaml.Unmarshal(data, &insights); err != nil {
+				t.Fatalf("generated file is not valid YAML: %v", err)
+			}
+			for _, contact := range append(insights.Project.Administrators, insights.Repository.CoreTeam...) {
+				if contact.Affiliation != tt.want {
+					t.Errorf("%s affiliation = %q, want %q", contact.Name, contact.Affiliation, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_MaintainerContacts(t *testing.T) {
+	tests := []struct {
+		name        string
+		maintainers []string
+		want        []insightsContact
+	}{
+		{
+			name:        "no maintainers",
+			maintainers: nil,
+			want: []insightsContact{
+				{Name: "Maintainer", Affiliation: "Acme", Email: "security@acme.com", Social: "https://github.com/maintainer", Primary: true},
+			},
+		},
+		{
+			name:        "email on the primary contact only",
+			maintainers: []string{"github:alice", "gitlab:bob"},
+			want: []insightsContact{
+				{Name: "alice", Affiliation: "Acme", Email: "security@acme.com", Social: "https://github.com/alice", Primary: true},
+				{Name: "bob", Affiliation: "Acme", Social: "https://gitlab.com/bob"},
+			},
+		},
+		{
+			name:        "same profile listed twice",
+			maintainers: []string{"github:alice", "@Alice", "gitlab:alice"},
+			want: []insightsContact{
+				{Name: "alice", Affiliation: "Acme", Email: "security@acme.com", Social: "https://github.com/alice", Primary: true},
+				{Name: "alice", Affiliation: "Acme", Social: "https://gitlab.com/alice"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maintainerContacts(tt.maintainers, "security@acme.com", "Acme")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("maintainerContacts() = %+v, want %+v", got, tt.want)
+			}
+
+			g := New(t.TempDir(), false)
+			config := g.DefaultConfig()
+			config.Maintainers = tt.maintainers
+			config.Affiliation = "Acme"
+			files, err := g.Render(config)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			result, err := validator.New().ValidateSecurityInsights(files["SECURITY-INSIGHTS.yml"])
+			if err != nil {
+				t.Fatalf("ValidateSecurityInsights() error = %v", err)
+			}
+			for _, warning := range result.Warnings {
+				if strings.Contains(warning, "email") {
+					t.Errorf("unexpected warning: %s", warning)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_DistributionPoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []string
+		want   []string
+	}{
+		{"none", nil, nil},
+		{
+			"two",
+			[]string{"https://github.com/acme/widget/releases", "https://pkg.go.dev/github.com/acme/widget"},
+			[]string{"https://github.com/acme/widget/releases", "https://pkg.go.dev/github.com/acme/widget"},
+		},
+		{"registry reference", []string{"ghcr.io/acme/widget"}, []string{"https://ghcr.io/acme/widget"}},
+		{"package URL", []string{"pkg:npm/widget"}, []string{"pkg:npm/widget"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			g := New(dir, true)
+			config := g.DefaultConfig()
+			config.DistributionPoints = tt.points
+
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, "SECURITY-INSIGHTS.yml"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			var insights sitooling.SecurityInsights
+			if err := yaml.Unmarshal(data, &insights); err != nil {
+				t.Fatalf("generated file is not valid YAML: %v", err)
+			}
+			got := insights.Repository.Release.DistributionPoints
+			if len(got) != len(tt.want) {
+				t.Fatalf("repository.release.distribution-points = %v, want %v", got, tt.want)
+			}
+			for i, point := range tt.want {
+				if got[i].URI != point {
+					t.Errorf("distribution point %d = %q, want %q", i, got[i].URI, point)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_SecurityInsightsEscaping(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	config := g.DefaultConfig()
+	config.ProjectName = `Acme: the "best"`
+	config.SecurityEmail = "security+#1@example.com"
+	config.Affiliation = "Acme, Inc.\nResearch"
+
+	if err := g.GenerateWithConfig(config); err != nil {
+		t.Fatalf("GenerateWithConfig() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "SECURITY-INSIGHTS.yml"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	var insights sitooling.SecurityInsights
+	if err := yaml.Unmarshal(data, &insights); err != nil {
+		t.Fatalf("generated file is not valid YAML: %v\n%s", err, data)
+	}
+	if insights.Project.Name != config.ProjectName {
+		t.Errorf("project.name = %q, want %q", insights.Project.Name, config.ProjectName)
+	}
+	admin := insights.Project.Administrators[0]
+	if admin.Email != config.SecurityEmail {
+		t.Errorf("administrator email = %q, want %q", admin.Email, config.SecurityEmail)
+	}
+	if admin.Affiliation != config.Affiliation {
+		t.Errorf("administrator affiliation = %q, want %q", admin.Affiliation, config.Affiliation)
+	}
+	if !strings.HasPrefix(string(data), "# OpenSSF Security Insights\n") {
+		t.Errorf("generated file does not start with the header comment")
+	}
+}
+
+func TestGenerator_License(t *testing.T) {
+	mit, _ := license.Text("MIT")
+
+	tests := []struct {
+		name     string
+		existing string // LICENSE content in the repository, if any
+		license  string // set on the config after DefaultConfig
+		want     string
+	}{
+		{name: "no LICENSE file", want: DefaultLicense},
+		{name: "detected from LICENSE", existing: mit, want: "MIT"},
+		{name: "unrecognized LICENSE", existing: "All rights reserved.\n", want: DefaultLicense},
+		{name: "configured", license: "MIT", want: "MIT"},
+		{name: "expression", license: "MIT OR Apache-2.0", want: "MIT OR Apache-2.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.existing != "" {
+				if err := os.WriteFile(filepath.Join(dir, "LICENSE"), []byte(tt.existing), 0644); err != nil {
+					t.Fatalf("failed to write LICENSE: %v", err)
+				}
+			}
+
+			g := New(dir, true)
+			config := g.DefaultConfig()
+			if tt.license != "" {
+				config.License = tt.license
+			}
+			if err := g.GenerateWithConfig(config); err != nil {
+				t.Fatalf("GenerateWithConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, "SECURITY-INSIGHTS.yml"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+
+			var insights sitooling.SecurityInsights
+			if err := yaml.Unmarshal(data, &insights); err != nil {
+				t.Fatalf("generated file is not valid YAML: %v", err)
+			}
+			if got := insights.Repository.License.Expression; got != tt.want {
+				t.Errorf("repository.license.expression = %q, want %q", got, tt.want)
+			}
+			if tt.want == "MIT" && !strings.Contains(string(data), "expression: MIT\n") {
+				t.Errorf("generated file does not contain expression: MIT")
+			}
+		})
+	}
+}
+
+func TestGenerator_ScorecardWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	g := New(dir, true)
+	if err := g.GenerateWorkflow("scorecard", "trunk"); err != nil {
+		t.Fatalf("GenerateWorkflow() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ".github", "workflows", "scorecard.yml"))
+	if err != nil {
+		t.Fatalf("workflow was not written: %v", err)
+	}
+	var workflow struct {
+		On struct {
+			Push struct {
+				Branches []string `yaml:"branches"`
+			} `yaml:"push"`
+		} `yaml:"on"`
+		Permissions string `yaml:"permissions"`
+		Jobs        map[string]struct {
+			Permissions map[string]string `yaml:"permissions"`
+			Steps       []struct {
+				Uses string `yaml:"uses"`
+			} `yaml:"steps"`
+		} `yaml:"jobs"`
+	}
+	if err := yaml.Unmarshal(data, &workflow); err != nil {
+		t.Fatalf("workflow is not valid YAML: %v", err)
+	}
+
+	if len(workflow.On.Push.Branches) != 1 || workflow.On.Push.Branches[0] != "trunk" {
+		t.Errorf("push branches = %v, want [trunk]", workflow.On.Push.Branches)
+	}
+	if workflow.Permissions != "read-all" {
+		t.Errorf("permissions = %q, want read-all", workflow.Permissions)
+	}
+	pinned := regexp.MustCompile(`^[\w./-]+@[0-9a-f]{40}$`)
+	scorecard := false
+	for _, job := range workflow.Jobs {
+		for scope, access := range job.Permissions {
+			if access == "write" && scope != "security-events" && scope != "id-token" {
+				t.Errorf("job has %s: write", scope)
+			}
+		}
+		for _, step := range job.Steps {
+			if step.Uses == "" {
+				continue
+			}
+			if !pinned.MatchString(step.Uses) {
+				t.Errorf("%s is not pinned to a commit SHA", step.Uses)
+			}
+			scorecard = scorecard || strings.HasPrefix(step.Uses, "ossf/scorecard-action@")
+		}
+	}
+	if !scorecard {
+		t.Error("workflow does not run ossf/scorecard-action")
+	}
+
+	if err := g.GenerateWorkflow("codeql", "main"); err == nil {
+		t.Error("GenerateWorkflow(codeql) succeeded, want an unknown workflow error")
+	}
+}
+
+func TestGenerator_WorkflowBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	origin := t.TempDir()
+	clone := t.TempDir()
+	for _, step := range []struct {
+		dir  string
+		args []string
+	}{
+		{origin, []string{"init", "-q", "-b", "trunk"}},
+		{origin, []string{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"}},
+		{clone, []string{"clone", "-q", "file://" + origin, "."}},
+	} {
+		cmd := exec.Command("git", step.args...)
+		cmd.Dir = step.dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v (%s)", step.args, err, out)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		dir    string
+		branch string
+		want   string
+	}{
+		{"detected", clone, "", "trunk"},
+		{"given", clone, "release", "release"},
+		{"unknown", t.TempDir(), "", "main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := New(tt.dir, true).GenerateWorkflow("scorecard", tt.branch); err != nil {
+				t.Fatalf("GenerateWorkflow() error = %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(tt.dir, ".github", "workflows", "scorecard.yml"))
+			if err != nil {
+				t.Fatalf("workflow was not written: %v", err)
+			}
+			if want := fmt.Sprintf(`branches: [ "%s" ]`, tt.want); !strings.Contains(string(data), want) {
+				t.Errorf("workflow does not contain %q:\n%s", want, data)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+// The types below mirror the parts of the Security Insights 2.0.0 schema the
+// generator writes. Marshaling them, rather than filling in a text template,
+// keeps values such as names with colons or quotes correctly escaped.
+
+// insightsHeaderComment is written above the generated document
+const insightsHeaderComment = `# OpenSSF Security Insights
+# Schema version 2.0.0
+# For more information, see: https://github.com/ossf/security-insights-spec
+
+`
+
+type insightsDocument struct {
+	Header     insightsHeader     `yaml:"header"`
+	Project    insightsProject    `yaml:"project"`
+	Repository insightsRepository `yaml:"repository"`
+}
+
+type insightsHeader struct {
+	SchemaVersion string `yaml:"schema-version"`
+	LastUpdated   string `yaml:"last-updated"`
+	LastReviewed  string `yaml:"last-reviewed"`
+	URL           string `yaml:"url"`
+	Comment       string `yaml:"comment,omitempty"`
+}
+
+type insightsProject struct {
+	Name                   string                `yaml:"name"`
+	Administrators         []insightsContact     `yaml:"administrators"`
+	VulnerabilityReporting insightsVulnReporting `yaml:"vulnerability-reporting"`
+}
+
+type insightsVulnReporting struct {
+	ReportsAccepted    bool             `yaml:"reports-accepted"`
+	BugBountyAvailable bool             `yaml:"bug-bounty-available"`
+	Contact            *insightsContact `yaml:"contact,omitempty"`
+	SecurityPolicy     string           `yaml:"security-policy,omitempty"`
+}
+
+type insightsRepository struct {
+	URL                           string            `yaml:"url"`
+	Status                        string            `yaml:"status"`
+	AcceptsChangeRequest          bool              `yaml:"accepts-change-request"`
+	AcceptsAutomatedChangeRequest bool              `yaml:"accepts-automated-change-request"`
+	BugFixesOnly                  bool              `yaml:"bug-fixes-only"`
+	CoreTeam                      []insightsContact `yaml:"core-team"`
+	License                       insightsLicense   `yaml:"license"`
+	Release                       insightsRelease   `yaml:"release"`
+	Security                      insightsSecurity  `yaml:"security"`
+}
+
+type insightsContact struct {
+	Name        string `yaml:"name"`
+	Affiliation string `yaml:"affiliation,omitempty"`
+	Email       string `yaml:"email,omitempty"`
+	Social      string `yaml:"social,omitempty"`
+	Primary     bool   `yaml:"primary"`
+}
+
+type insightsLicense struct {
+	URL        string `yaml:"url"`
+	Expression string `yaml:"expression"`
+}
+
+type insightsRelease struct {
+	AutomatedPipeline  bool           `yaml:"automated-pipeline"`
+	DistributionPoints []insightsLink `yaml:"distribution-points"`
+}
+
+type insightsLink struct {
+	URI     string `yaml:"uri"`
+	Comment string `yaml:"comment,omitempty"`
+}
+
+type insightsSecurity struct {
+	Assessments struct {
+		Self struct {
+			Comment string `yaml:"comment"`
+		} `yaml:"self"`
+	} `yaml:"assessments"`
+}
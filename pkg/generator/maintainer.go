@@ -0,0 +1,64 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"strings"
+)
+
+// Maintainer is a maintainer identity on a hosting or social platform
+type Maintainer struct {
+	Platform string // github, gitlab or mastodon
+	Handle   string // username; user@host for mastodon
+}
+
+// ParseMaintainer parses a maintainer spec such as "github:alice",
+// "gitlab:bob" or "mastodon:@carol@example.social". A spec without a
+// recognized platform prefix is treated as a GitHub username.
+func ParseMaintainer(spec string) Maintainer {
+	spec = strings.TrimSpace(spec)
+
+	if platform, handle, ok := strings.Cut(spec, ":"); ok {
+		switch strings.ToLower(platform) {
+		case "github", "gitlab":
+			return Maintainer{Platform: strings.ToLower(platform), Handle: strings.TrimPrefix(handle, "@")}
+		case "mastodon":
+			return Maintainer{Platform: "mastodon", Handle: strings.TrimPrefix(handle, "@")}
+		}
+	}
+
+	return Maintainer{Platform: "github", Handle: strings.TrimPrefix(spec, "@")}
+}
+
+// Name returns the display name used for the maintainer, which is the
+// handle without any instance host
+func (m Maintainer) Name() string {
+	name, _, _ := strings.Cut(m.Handle, "@")
+	return name
+}
+
+// SocialURL returns the maintainer's profile URL on their platform
+func (m Maintainer) SocialURL() string {
+	switch m.Platform {
+	case "gitlab":
+		return "https://gitlab.com/" + m.Handle
+	case "mastodon":
+		user, host, ok := strings.Cut(m.Handle, "@")
+		if !ok {
+			// No instance given; there is no canonical host to link to
+			return "https://mastodon.social/@" + user
+		}
+		return "https://" + host + "/@" + user
+	default:
+		return "https://github.com/" + m.Handle
+	}
+}
+
+// String returns the maintainer in spec form, e.g. "gitlab:bob"
+func (m Maintainer) String() string {
+	if m.Platform == "mastodon" {
+		return "mastodon:@" + m.Handle
+	}
+	return m.Platform + ":" + m.Handle
+}
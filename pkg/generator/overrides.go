@@ -0,0 +1,69 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ApplyOverrides applies key=value pairs to config, in order. Keys are
+// Config field names (SecurityEmail) or their YAML names (security-email),
+// matched case-insensitively.
+func ApplyOverrides(config *Config, overrides []string) error {
+	for _, override := range overrides {
+		key, value, ok := strings.Cut(override, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return fmt.Errorf("invalid override %q: expected key=value", override)
+		}
+
+		if err := config.Set(strings.TrimSpace(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set assigns value to the field named by key, converting it to the field's
+// type. Bools accept the forms understood by strconv.ParseBool; string lists
+// are comma-separated, and an empty value clears the list.
+func (c *Config) Set(key, value string) error {
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		yamlName, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if !strings.EqualFold(key, field.Name) && !strings.EqualFold(key, yamlName) {
+			continue
+		}
+
+		target := v.Field(i)
+		switch target.Kind() {
+		case reflect.String:
+			target.SetString(value)
+		case reflect.Bool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %q is not a boolean", field.Name, value)
+			}
+			target.SetBool(b)
+		case reflect.Slice:
+			items := []string{}
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			target.Set(reflect.ValueOf(items))
+		default:
+			return fmt.Errorf("cannot override %s", field.Name)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown config key: %s", key)
+}
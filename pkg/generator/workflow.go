@@ -0,0 +1,97 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// workflows are the GitHub Actions workflows generate-workflow can write, by
+// name. Each renders the workflow for the repository's default branch.
+var workflows = map[string]func(branch string) []byte{
+	"scorecard": scorecardWorkflow,
+}
+
+// Workflows returns the names of the workflows GenerateWorkflow accepts
+func Workflows() []string {
+	names := make([]string, 0, len(workflows))
+	for name := range workflows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenerateWorkflow writes the named workflow to .github/workflows/<name>.yml,
+// running on pushes to branch. An empty branch means the repository's
+// default branch, or "main" when it cannot be detected.
+func (g *Generator) GenerateWorkflow(name, branch string) error {
+	render, ok := workflows[name]
+	if !ok {
+		return fmt.Errorf("unknown workflow %q (available: %v)", name, Workflows())
+	}
+	if branch == "" {
+		branch = defaultBranch(g.repoPath)
+	}
+	if branch == "" {
+		branch = "main"
+	}
+
+	dir := filepath.Join(g.repoPath, ".github", "workflows")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create workflows directory: %w", err)
+	}
+	return g.writeFile(filepath.Join(".github", "workflows", name+".yml"), render(branch))
+}
+
+// scorecardWorkflow renders an OpenSSF Scorecard workflow. Actions are
+// pinned to commit SHAs, and the workflow only gets the permissions
+// Scorecard needs to publish its results and upload them to code scanning.
+func scorecardWorkflow(branch string) []byte {
+	return []byte(fmt.Sprintf(`# OpenSSF Scorecard supply-chain security analysis
+# https://github.com/ossf/scorecard-action
+name: Scorecard supply-chain security
+
+on:
+  branch_protection_rule:
+  schedule:
+    - cron: '30 1 * * 6'
+  push:
+    branches: [ "%s" ]
+
+# Read-only by default; the job below asks for what it needs
+permissions: read-all
+
+jobs:
+  analysis:
+    name: Scorecard analysis
+    runs-on: ubuntu-latest
+    permissions:
+      # Upload the results to code scanning
+      security-events: write
+      # Publish the results and get a badge
+      id-token: write
+
+    steps:
+      - name: Checkout code
+        uses: actions/checkout@11bd71901bbe5b1630ceea73d27597364c9af683 # v4.2.2
+        with:
+          persist-credentials: false
+
+      - name: Run analysis
+        uses: ossf/scorecard-action@62b2cac7ed8198b15735ed49ab1e5cf35480ba46 # v2.4.0
+        with:
+          results_file: results.sarif
+          results_format: sarif
+          publish_results: true
+
+      - name: Upload to code scanning
+        uses: github/codeql-action/upload-sarif@48ab28a6f5dbc2a99bf1e0131198dd8f1df78169 # v3.28.0
+        with:
+          sarif_file: results.sarif
+`, branch))
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBaseURL is the public GitHub REST API endpoint
+const DefaultBaseURL = "https://api.github.com"
+
+const (
+	defaultMaxRetries = 3
+	initialBackoff    = time.Second
+	// maxRateLimitWait is the longest the client will sleep waiting for a
+	// rate limit window to reset before giving up
+	maxRateLimitWait = time.Minute
+)
+
+// Client is a small GitHub REST API client shared by features that talk to
+// GitHub. It authenticates with GITHUB_TOKEN when set, retries transient
+// failures with backoff and honors rate limit headers.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+	maxRetries int
+	sleep      func(context.Context, time.Duration) error
+
+	mu        sync.Mutex
+	rateLimit RateLimit
+}
+
+// RateLimit is the API quota reported by the most recent response
+type RateLimit struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+// APIError is returned for non-successful API responses
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("GitHub API error %d: %s", e.StatusCode, e.Message)
+}
+
+// NewClient creates a Client using GITHUB_TOKEN from the environment
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    DefaultBaseURL,
+		token:      os.Getenv("GITHUB_TOKEN"),
+		maxRetries: defaultMaxRetries,
+		sleep:      sleepContext,
+	}
+}
+
+// SetBaseURL points the client at a different API endpoint, such as a
+// GitHub Enterprise Server instance
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+// HasToken reports whether requests are authenticated
+func (c *Client) HasToken() bool {
+	return c.token != ""
+}
+
+// RateLimit returns the quota reported by the most recent response
+func (c *Client) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+// Get requests path (relative to the API base URL) and decodes the JSON
+// response into v
+func (c *Client) Get(ctx context.Context, path string, v interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, v)
+}
+
+// Post sends body as JSON to path and decodes the JSON response into v
+func (c *Client) Post(ctx context.Context, path string, body, v interface{}) error {
+	return c.do(ctx, http.MethodPost, path, body, v)
+}
+
+// Patch sends body as JSON to path and decodes the JSON response into v
+func (c *Client) Patch(ctx context.Context, path string, body, v interface{}) error {
+	return c.do(ctx, http.MethodPatch, path, body, v)
+}
+
+// do performs a request and decodes the JSON response into v. Requests
+// that change state are only retried when rate limited, since a server
+// error or dropped connection may have hidden a write that succeeded.
+func (c *Client) do(ctx context.Context, method, path string, body, v interface{}) error {
+	url := c.baseURL + "/" + strings.TrimPrefix(path, "/")
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+	idempotent := method == http.MethodGet
+
+	backoff := initialBackoff
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if payload != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if !idempotent || attempt >= c.maxRetries || ctx.Err() != nil {
+				return fmt.Errorf("request to %s failed: %w", url, err)
+			}
+			if err := c.sleep(ctx, backoff); err != nil {
+				return err
+			}
+			backoff *= 2
+			continue
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		c.recordRateLimit(resp.Header)
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			if v == nil {
+				return nil
+			}
+			if err := json.Unmarshal(respBody, v); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			return nil
+		}
+
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: errorMessage(respBody, resp.Status)}
+		wait, retryable := c.retryDelay(resp, backoff)
+		if !idempotent && resp.StatusCode >= 500 {
+			retryable = false
+		}
+		if !retryable || attempt >= c.maxRetries {
+			return apiErr
+		}
+		if wait > maxRateLimitWait {
+			return fmt.Errorf("%w (rate limit resets in %s)", apiErr, wait.Round(time.Second))
+		}
+		if err := c.sleep(ctx, wait); err != nil {
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// retryDelay decides whether a failed response should be retried and how
+// long to wait first, preferring the server's Retry-After and rate limit
+// reset hints over exponential backoff
+func (c *Client) retryDelay(resp *http.Response, backoff time.Duration) (time.Duration, bool) {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	rateLimited := resp.StatusCode == http.StatusTooManyRequests ||
+		(resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0")
+	if rateLimited {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			wait := time.Until(time.Unix(reset, 0))
+			if wait < 0 {
+				wait = 0
+			}
+			return wait, true
+		}
+		return backoff, true
+	}
+
+	if resp.StatusCode >= 500 {
+		return backoff, true
+	}
+	return 0, false
+}
+
+// recordRateLimit stores the quota headers from a response
+func (c *Client) recordRateLimit(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimit = RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+}
+
+// errorMessage extracts the message field from a GitHub error response
+func errorMessage(body []byte, status string) string {
+	var payload struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Message != "" {
+		return payload.Message
+	}
+	return status
+}
+
+// sleepContext waits for d or until ctx is cancelled
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
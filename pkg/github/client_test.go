@@ -0,0 +1,172 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *[]time.Duration) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	slept := []time.Duration{}
+	c := NewClient()
+	c.token = "test-token"
+	c.SetBaseURL(server.URL)
+	c.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	return c, &slept
+}
+
+func TestClient_Get(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want bearer token", got)
+		}
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Write([]byte(`{"full_name": "example/repo"}`))
+	})
+
+	var repo struct {
+		FullName string `json:"full_name"`
+	}
+	if err := c.Get(context.Background(), "/repos/example/repo", &repo); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if repo.FullName != "example/repo" {
+		t.Errorf("FullName = %q, want example/repo", repo.FullName)
+	}
+	if rl := c.RateLimit(); rl.Limit != 5000 || rl.Remaining != 4999 {
+		t.Errorf("RateLimit() = %+v, want limit 5000 remaining 4999", rl)
+	}
+}
+
+func TestClient_Retries(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  []func(w http.ResponseWriter)
+		wantErr   bool
+		wantSleep []time.Duration
+	}{
+		{
+			name: "retry-after on secondary rate limit",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "7")
+					w.WriteHeader(http.StatusForbidden)
+				},
+			},
+			wantSleep: []time.Duration{7 * time.Second},
+		},
+		{
+			name: "server errors back off exponentially",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			wantSleep: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name: "not found is not retried",
+			failures: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"message": "Not Found"}`))
+				},
+			},
+			wantErr:   true,
+			wantSleep: []time.Duration{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			c, slept := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				defer func() { calls++ }()
+				if calls < len(tt.failures) {
+					tt.failures[calls](w)
+					return
+				}
+				w.Write([]byte(`{}`))
+			})
+
+			err := c.Get(context.Background(), "repos/example/repo", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var apiErr *APIError
+			if tt.wantErr && !errors.As(err, &apiErr) {
+				t.Errorf("error %v is not an *APIError", err)
+			}
+
+			if len(*slept) != len(tt.wantSleep) {
+				t.Fatalf("slept %v, want %v", *slept, tt.wantSleep)
+			}
+			for i := range tt.wantSleep {
+				if (*slept)[i] != tt.wantSleep[i] {
+					t.Errorf("sleep[%d] = %v, want %v", i, (*slept)[i], tt.wantSleep[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClient_RateLimitResetTooFar(t *testing.T) {
+	c, slept := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "4102444800") // far in the future
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	if err := c.Get(context.Background(), "repos/example/repo", nil); err == nil {
+		t.Fatal("Get() error = nil, want rate limit error")
+	}
+	if len(*slept) != 0 {
+		t.Errorf("slept %v, want no sleep when reset is too far away", *slept)
+	}
+	if rl := c.RateLimit(); rl.Remaining != 0 || rl.Limit != 60 {
+		t.Errorf("RateLimit() = %+v, want exhausted quota", rl)
+	}
+}
+
+func TestParseRepoURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		wantOwner string
+		wantName  string
+		wantOK    bool
+	}{
+		{"https://github.com/example/repo", "example", "repo", true},
+		{"git@github.com:example/repo.git", "example", "repo", true},
+		{"https://github.com/example/repo/tree/main", "example", "repo", true},
+		{"https://gitlab.com/example/repo", "", "", false},
+		{"https://github.com/example", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, name, ok := ParseRepoURL(tt.url)
+			if owner != tt.wantOwner || name != tt.wantName || ok != tt.wantOK {
+				t.Errorf("ParseRepoURL() = (%q, %q, %v), want (%q, %q, %v)",
+					owner, name, ok, tt.wantOwner, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Issue is the subset of GitHub issue data used to track findings
+type Issue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+	// PullRequest is set when the "issue" is a pull request, which the
+	// issues API lists alongside real issues
+	PullRequest *struct{} `json:"pull_request,omitempty"`
+}
+
+// FindIssue returns the most recently created issue in owner/name, open or
+// closed, whose body contains marker, or nil when there is none. Tools that
+// file issues embed a hidden marker so they can find and update their own
+// issue instead of opening duplicates; closed issues are included so a
+// finding that comes back reopens its old issue.
+func (c *Client) FindIssue(ctx context.Context, owner, name, marker string) (*Issue, error) {
+	for page := 1; ; page++ {
+		var batch []Issue
+		path := fmt.Sprintf("/repos/%s/%s/issues?state=all&per_page=%d&page=%d", owner, name, orgPageSize, page)
+		if err := c.Get(ctx, path, &batch); err != nil {
+			return nil, err
+		}
+		for i := range batch {
+			if batch[i].PullRequest == nil && strings.Contains(batch[i].Body, marker) {
+				return &batch[i], nil
+			}
+		}
+		if len(batch) < orgPageSize {
+			return nil, nil
+		}
+	}
+}
+
+// CreateIssue opens an issue in owner/name
+func (c *Client) CreateIssue(ctx context.Context, owner, name, title, body string) (*Issue, error) {
+	var issue Issue
+	request := map[string]string{"title": title, "body": body}
+	if err := c.Post(ctx, fmt.Sprintf("/repos/%s/%s/issues", owner, name), request, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// UpdateIssue replaces the title and body of an existing issue, reopening
+// it if it was closed
+func (c *Client) UpdateIssue(ctx context.Context, owner, name string, number int, title, body string) (*Issue, error) {
+	var issue Issue
+	request := map[string]string{"title": title, "body": body, "state": "open"}
+	if err := c.Patch(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d", owner, name, number), request, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// CloseIssue closes an existing issue as completed
+func (c *Client) CloseIssue(ctx context.Context, owner, name string, number int) error {
+	request := map[string]string{"state": "closed", "state_reason": "completed"}
+	return c.Patch(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d", owner, name, number), request, nil)
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_ListOrgRepositories(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/example/repos" {
+			t.Errorf("path = %s, want /orgs/example/repos", r.URL.Path)
+		}
+		var repos []Repository
+		switch r.URL.Query().Get("page") {
+		case "1":
+			for i := 0; i < orgPageSize; i++ {
+				repos = append(repos, Repository{Name: fmt.Sprintf("repo%d", i)})
+			}
+		case "2":
+			repos = append(repos, Repository{Name: "last", Archived: true})
+		}
+		json.NewEncoder(w).Encode(repos)
+	})
+
+	repos, err := c.ListOrgRepositories(context.Background(), "example")
+	if err != nil {
+		t.Fatalf("ListOrgRepositories() error = %v", err)
+	}
+	if len(repos) != orgPageSize+1 {
+		t.Fatalf("got %d repositories, want %d", len(repos), orgPageSize+1)
+	}
+	if last := repos[len(repos)-1]; last.Name != "last" || !last.Archived {
+		t.Errorf("last repository = %+v, want archived \"last\"", last)
+	}
+}
+
+func TestClient_ListDirectory(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/example/repo/contents/":
+			w.Write([]byte(`[{"path": "LICENSE"}, {"path": "README.md"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	})
+
+	entries, err := c.ListDirectory(context.Background(), "example", "repo", "")
+	if err != nil {
+		t.Fatalf("ListDirectory() error = %v", err)
+	}
+	if strings.Join(entries, ",") != "LICENSE,README.md" {
+		t.Errorf("ListDirectory() = %v", entries)
+	}
+
+	entries, err = c.ListDirectory(context.Background(), "example", "repo", "docs")
+	if err != nil || entries != nil {
+		t.Errorf("ListDirectory() on a missing directory = %v, %v; want no entries", entries, err)
+	}
+}
+
+func TestClient_FindIssue(t *testing.T) {
+	const marker = "<!-- tracking -->"
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Closed issues are searched too, so a finding that comes back
+		// does not open a duplicate
+		if got := r.URL.Query().Get("state"); got != "all" {
+			t.Errorf("state = %q, want all", got)
+		}
+		w.Write([]byte(`[
+			{"number": 1, "body": "unrelated"},
+			{"number": 2, "body": "` + marker + ` in a pull request", "pull_request": {}},
+			{"number": 3, "body": "` + marker + ` tracking issue", "state": "closed"}
+		]`))
+	})
+
+	issue, err := c.FindIssue(context.Background(), "example", "repo", marker)
+	if err != nil {
+		t.Fatalf("FindIssue() error = %v", err)
+	}
+	if issue == nil || issue.Number != 3 || issue.State != "closed" {
+		t.Errorf("FindIssue() = %+v, want closed issue #3", issue)
+	}
+
+	issue, err = c.FindIssue(context.Background(), "example", "repo", "<!-- other -->")
+	if err != nil || issue != nil {
+		t.Errorf("FindIssue() = %+v, %v; want nil", issue, err)
+	}
+}
+
+func TestClient_CreateIssue(t *testing.T) {
+	calls := 0
+	c, slept := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("request body is not JSON: %v", err)
+		}
+		if request["title"] != "Title" || request["body"] != "Body" {
+			t.Errorf("request = %v", request)
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, err := c.CreateIssue(context.Background(), "example", "repo", "Title", "Body"); err == nil {
+		t.Fatal("CreateIssue() error = nil, want server error")
+	}
+	// A write that failed with a server error may still have happened, so
+	// retrying could open a duplicate issue
+	if calls != 1 || len(*slept) != 0 {
+		t.Errorf("CreateIssue() made %d calls and slept %v, want a single attempt", calls, *slept)
+	}
+}
+
+func TestClient_UpdateIssueReopens(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/repos/example/repo/issues/3" {
+			t.Errorf("request = %s %s, want PATCH /repos/example/repo/issues/3", r.Method, r.URL.Path)
+		}
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("request body is not JSON: %v", err)
+		}
+		if request["state"] != "open" {
+			t.Errorf("state = %q, want open", request["state"])
+		}
+		w.Write([]byte(`{"number": 3, "state": "open"}`))
+	})
+
+	issue, err := c.UpdateIssue(context.Background(), "example", "repo", 3, "Title", "Body")
+	if err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if issue.State != "open" {
+		t.Errorf("UpdateIssue() = %+v, want an open issue", issue)
+	}
+}
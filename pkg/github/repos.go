@@ -0,0 +1,132 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+)
+
+// Repository is the subset of GitHub repository metadata used by the checks
+type Repository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Archived bool   `json:"archived"`
+	Fork     bool   `json:"fork"`
+}
+
+// orgPageSize is the largest page the repository listing endpoints allow
+const orgPageSize = 100
+
+// GetRepository fetches metadata for owner/name
+func (c *Client) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	var repo Repository
+	if err := c.Get(ctx, fmt.Sprintf("/repos/%s/%s", owner, name), &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// PrivateVulnerabilityReporting reports whether owner/name accepts private
+// vulnerability reports through GitHub security advisories
+func (c *Client) PrivateVulnerabilityReporting(ctx context.Context, owner, name string) (bool, error) {
+	var status struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := c.Get(ctx, fmt.Sprintf("/repos/%s/%s/private-vulnerability-reporting", owner, name), &status); err != nil {
+		return false, err
+	}
+	return status.Enabled, nil
+}
+
+// ListOrgRepositories fetches every repository in org, following pagination
+func (c *Client) ListOrgRepositories(ctx context.Context, org string) ([]Repository, error) {
+	var repos []Repository
+	for page := 1; ; page++ {
+		var batch []Repository
+		path := fmt.Sprintf("/orgs/%s/repos?type=all&per_page=%d&page=%d", org, orgPageSize, page)
+		if err := c.Get(ctx, path, &batch); err != nil {
+			return nil, err
+		}
+		repos = append(repos, batch...)
+		if len(batch) < orgPageSize {
+			return repos, nil
+		}
+	}
+}
+
+// ListDirectory returns the repository-relative paths of the entries in
+// dir on the default branch; an empty dir lists the root. A directory that
+// does not exist has no entries rather than being an error.
+func (c *Client) ListDirectory(ctx context.Context, owner, name, dir string) ([]string, error) {
+	var entries []struct {
+		Path string `json:"path"`
+	}
+	err := c.Get(ctx, fmt.Sprintf("/repos/%s/%s/contents/%s", owner, name, dir), &entries)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	return paths, nil
+}
+
+// GetFileContent returns the content of the file at path on the default
+// branch of owner/name. found is false, with no error, when there is no file
+// at path, so callers can tell a missing file from a failed request.
+func (c *Client) GetFileContent(ctx context.Context, owner, name, path string) (content []byte, found bool, err error) {
+	var file struct {
+		Type     string `json:"type"`
+		Encoding string `json:"encoding"`
+		Content  string `json:"content"`
+	}
+	err = c.Get(ctx, fmt.Sprintf("/repos/%s/%s/contents/%s", owner, name, path), &file)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if file.Type != "file" {
+		return nil, false, nil
+	}
+	if file.Encoding != "base64" {
+		return nil, false, fmt.Errorf("%s has unsupported encoding %q", path, file.Encoding)
+	}
+
+	content, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(file.Content, "\n", ""))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return content, true, nil
+}
+
+// ParseRepoURL extracts the owner and repository name from a github.com
+// remote or web URL in any of the forms gitutil.NormalizeURL accepts
+func ParseRepoURL(url string) (owner, name string, ok bool) {
+	path, found := strings.CutPrefix(gitutil.NormalizeURL(url), "github.com/")
+	if !found {
+		return "", "", false
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
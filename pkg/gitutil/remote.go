@@ -0,0 +1,257 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitutil
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DetectRemote attempts to detect the Git remote URL of a repository,
+// converting SSH remotes to their HTTPS form with RemoteHTTPSURL
+func DetectRemote(repoPath string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", "remote.origin.url")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return RemoteHTTPSURL(strings.TrimSpace(string(output))), nil
+}
+
+// RemoteHTTPSURL converts an SSH remote on any host, such as
+// git@gitlab.com:group/repo.git or ssh://git@bitbucket.org/team/repo.git, to
+// its HTTPS form, and strips a trailing ".git". The SSH port of an ssh://
+// URL is dropped, as are credentials in an HTTP(S) remote, so that neither
+// ends up in generated metadata. Other remotes only lose the ".git".
+func RemoteHTTPSURL(remote string) string {
+	remote = strings.TrimSuffix(remote, ".git")
+
+	if rest, ok := strings.CutPrefix(remote, "ssh://"); ok {
+		hostPart, path, _ := strings.Cut(rest, "/")
+		if at := strings.LastIndex(hostPart, "@"); at >= 0 {
+			hostPart = hostPart[at+1:]
+		}
+		host, _, _ := strings.Cut(hostPart, ":")
+		return "https://" + host + "/" + path
+	}
+
+	if u, err := url.Parse(remote); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		u.User = nil
+		return u.String()
+	}
+
+	// scp-style user@host:path, where the user part comes before any slash
+	if !strings.Contains(remote, "://") {
+		at := strings.Index(remote, "@")
+		colon := strings.Index(remote, ":")
+		if at > 0 && colon > at && !strings.Contains(remote[:colon], "/") {
+			return "https://" + remote[at+1:colon] + "/" + strings.TrimPrefix(remote[colon+1:], "/")
+		}
+	}
+
+	return remote
+}
+
+// NormalizeURL reduces a repository URL to a comparable "host/owner/repo"
+// form, ignoring scheme, SSH user, letter case, trailing slashes and a
+// ".git" suffix
+func NormalizeURL(url string) string {
+	url = strings.TrimSpace(strings.ToLower(url))
+
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git+https://"} {
+		url = strings.TrimPrefix(url, prefix)
+	}
+
+	// git@host:owner/repo and git@host/owner/repo
+	if at := strings.Index(url, "@"); at >= 0 && at < strings.Index(url+"/", "/") {
+		url = url[at+1:]
+		url = strings.Replace(url, ":", "/", 1)
+	}
+
+	url = strings.TrimSuffix(url, "/")
+	url = strings.TrimSuffix(url, ".git")
+	return strings.TrimSuffix(url, "/")
+}
+
+// LastCommitTime returns the committer date of the repository's HEAD commit
+func LastCommitTime(repoPath string) (time.Time, error) {
+	cmd := exec.Command("git", "log", "-1", "--format=%cI")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(output)))
+}
+
+// HeadCommit returns the full SHA of the repository's HEAD commit
+func HeadCommit(repoPath string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// DefaultBranch returns the branch origin's HEAD points at, such as "main",
+// as recorded by git clone or "git remote set-head origin --auto". It fails
+// when there is no origin or its HEAD is unknown.
+func DefaultBranch(repoPath string) (string, error) {
+	cmd := exec.Command("git", "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(strings.TrimSpace(string(output)), "origin/"), nil
+}
+
+// LatestTag returns the most recent tag reachable from HEAD, such as
+// "v1.4.2". It fails when the repository has no tags.
+func LatestTag(repoPath string) (string, error) {
+	cmd := exec.Command("git", "describe", "--tags", "--abbrev=0")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// ErrShallow is returned by history queries that would give misleading
+// answers in a shallow clone, whose oldest commit is not the real root
+var ErrShallow = errors.New("repository is a shallow clone")
+
+// IsShallow reports whether the repository is a shallow clone
+func IsShallow(repoPath string) (bool, error) {
+	cmd := exec.Command("git", "rev-parse", "--is-shallow-repository")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(string(output)) == "true", nil
+}
+
+// FirstCommitTime returns the committer date of the repository's earliest
+// root commit, which approximates when the repository was created. It
+// returns ErrShallow in a shallow clone.
+func FirstCommitTime(repoPath string) (time.Time, error) {
+	shallow, err := IsShallow(repoPath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if shallow {
+		return time.Time{}, ErrShallow
+	}
+
+	cmd := exec.Command("git", "log", "--max-parents=0", "--format=%cI", "HEAD")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var first time.Time
+	for _, line := range strings.Fields(string(output)) {
+		t, err := time.Parse(time.RFC3339, line)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+	}
+	if first.IsZero() {
+		return time.Time{}, fmt.Errorf("no commits found")
+	}
+	return first, nil
+}
+
+// File tracking states reported by TrackingState
+const (
+	Tracked   = "tracked"
+	Untracked = "untracked"
+	Ignored   = "ignored"
+)
+
+// TrackingState reports whether path (relative to repoPath or absolute) is
+// tracked by git, untracked, or ignored. Staged files count as tracked. It
+// fails when repoPath is not inside a git work tree.
+func TrackingState(repoPath, path string) (string, error) {
+	check := exec.Command("git", "rev-parse", "--is-inside-work-tree")
+	check.Dir = repoPath
+	if err := check.Run(); err != nil {
+		return "", err
+	}
+
+	lsFiles := exec.Command("git", "ls-files", "--error-unmatch", "--", path)
+	lsFiles.Dir = repoPath
+	if err := lsFiles.Run(); err == nil {
+		return Tracked, nil
+	}
+
+	checkIgnore := exec.Command("git", "check-ignore", "-q", "--", path)
+	checkIgnore.Dir = repoPath
+	if err := checkIgnore.Run(); err == nil {
+		return Ignored, nil
+	}
+	return Untracked, nil
+}
+
+// Author is a commit author and the number of commits reachable from HEAD
+type Author struct {
+	Name    string
+	Email   string
+	Commits int
+}
+
+// CommitAuthors returns everyone who authored a commit reachable from HEAD,
+// most prolific first. A non-zero since limits it to commits made after then.
+func CommitAuthors(repoPath string, since time.Time) ([]Author, error) {
+	args := []string{"shortlog", "--summary", "--numbered", "--email"}
+	if !since.IsZero() {
+		args = append(args, "--since="+since.Format(time.RFC3339))
+	}
+	cmd := exec.Command("git", append(args, "HEAD")...)
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var authors []Author
+	for _, line := range strings.Split(string(output), "\n") {
+		count, ident, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		commits, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected shortlog line %q", line)
+		}
+
+		author := Author{Name: ident, Commits: commits}
+		if open := strings.LastIndex(ident, " <"); open >= 0 && strings.HasSuffix(ident, ">") {
+			author.Name = ident[:open]
+			author.Email = ident[open+2 : len(ident)-1]
+		}
+		authors = append(authors, author)
+	}
+	return authors, nil
+}
@@ -5,26 +5,33 @@ package interactive
 
 import (
 	"fmt"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
 	"github.com/aguamala/baseline-init/pkg/generator"
+	"github.com/aguamala/baseline-init/pkg/gitutil"
+	"github.com/aguamala/baseline-init/pkg/validator"
 	"github.com/manifoldco/promptui"
 )
 
-// GatherConfiguration interactively gathers configuration from the user
-func GatherConfiguration(repoPath string) (*generator.Config, error) {
+// GatherConfiguration interactively gathers configuration from the user.
+// projectURL is offered as the default project URL; when empty, it is
+// detected from the repository's git remote.
+func GatherConfiguration(repoPath, projectURL string) (*generator.Config, error) {
 	config := &generator.Config{}
+	config.Branch, _ = gitutil.DefaultBranch(repoPath)
 
 	fmt.Println("🔧 OpenSSF Baseline Interactive Setup")
 	fmt.Println("======================================")
 	fmt.Println()
 
 	// Project URL
-	projectURL, err := detectGitRemote(repoPath)
-	if err != nil {
-		projectURL = ""
+	var err error
+	if projectURL == "" {
+		projectURL, err = gitutil.DetectRemote(repoPath)
+		if err != nil {
+			projectURL = ""
+		}
 	}
 
 	urlPrompt := promptui.Prompt{
@@ -49,14 +56,9 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 
 	// Security Email
 	emailPrompt := promptui.Prompt{
-		Label:   "Security Contact Email",
-		Default: "security@example.com",
-		Validate: func(input string) error {
-			if !strings.Contains(input, "@") {
-				return fmt.Errorf("invalid email address")
-			}
-			return nil
-		},
+		Label:    "Security Contact Email",
+		Default:  "security@example.com",
+		Validate: validator.ValidateEmail,
 	}
 	config.SecurityEmail, err = emailPrompt.Run()
 	if err != nil {
@@ -66,7 +68,7 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 	// Project Stage
 	stagePrompt := promptui.Select{
 		Label: "Project Lifecycle Stage",
-		Items: []string{"active", "archived", "concept", "moved", "wip"},
+		Items: generator.ProjectStages,
 	}
 	_, config.ProjectStage, err = stagePrompt.Run()
 	if err != nil {
@@ -84,6 +86,19 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 	}
 	config.AcceptsVulnReports = vulnResponse == "Yes"
 
+	// Private Vulnerability Reporting
+	if config.AcceptsVulnReports {
+		privatePrompt := promptui.Select{
+			Label: "GitHub Private Vulnerability Reporting Enabled",
+			Items: []string{"Yes", "No"},
+		}
+		_, privateResponse, err := privatePrompt.Run()
+		if err != nil {
+			return nil, fmt.Errorf("prompt failed: %w", err)
+		}
+		config.PrivateReporting = privateResponse == "Yes"
+	}
+
 	// Accepts Pull Requests
 	prPrompt := promptui.Select{
 		Label: "Accept Pull Requests",
@@ -119,7 +134,7 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 
 	// Maintainers
 	maintainerPrompt := promptui.Prompt{
-		Label:   "GitHub Maintainer Username(s) (comma-separated)",
+		Label:   "Maintainer(s) (comma-separated; username, gitlab:user or mastodon:@user@host)",
 		Default: "maintainer",
 	}
 	maintainerInput, err := maintainerPrompt.Run()
@@ -132,16 +147,46 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 	for _, m := range maintainers {
 		m = strings.TrimSpace(m)
 		if m != "" {
-			if !strings.HasPrefix(m, "github:") {
-				m = "github:" + m
-			}
-			config.Maintainers = append(config.Maintainers, m)
+			config.Maintainers = append(config.Maintainers, generator.ParseMaintainer(m).String())
 		}
 	}
 
+	// Affiliation
+	affiliationPrompt := promptui.Prompt{
+		Label:   "Maintainer affiliation (organization, or press Enter to fill in later)",
+		Default: "",
+	}
+	config.Affiliation, err = affiliationPrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("prompt failed: %w", err)
+	}
+	config.Affiliation = strings.TrimSpace(config.Affiliation)
+
+	// License
+	licensePrompt := promptui.Prompt{
+		Label:   "License (SPDX expression)",
+		Default: generator.DetectLicense(repoPath),
+	}
+	config.License, err = licensePrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("prompt failed: %w", err)
+	}
+	config.License = strings.TrimSpace(config.License)
+
+	// Supported Version
+	versionPrompt := promptui.Prompt{
+		Label:   "Supported release line for SECURITY.md, e.g. 1.4.x (or press Enter to leave a placeholder)",
+		Default: generator.DetectSupportedVersion(repoPath),
+	}
+	config.SupportedVersion, err = versionPrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("prompt failed: %w", err)
+	}
+	config.SupportedVersion = strings.TrimSpace(config.SupportedVersion)
+
 	// Distribution Points
 	distPrompt := promptui.Prompt{
-		Label:   "Distribution Points (URLs, comma-separated, or press Enter to skip)",
+		Label:   "Distribution Points (URLs or registry references such as ghcr.io/owner/image, comma-separated, or press Enter to skip)",
 		Default: "",
 	}
 	distInput, err := distPrompt.Run()
@@ -164,22 +209,15 @@ func GatherConfiguration(repoPath string) (*generator.Config, error) {
 	return config, nil
 }
 
-// detectGitRemote attempts to detect the Git remote URL
-func detectGitRemote(repoPath string) (string, error) {
-	cmd := exec.Command("git", "config", "--get", "remote.origin.url")
-	cmd.Dir = repoPath
-	output, err := cmd.Output()
-	if err != nil {
-		return "", err
-	}
-
-	url := strings.TrimSpace(string(output))
-
-	// Convert SSH URL to HTTPS
-	if strings.HasPrefix(url, "git@github.com:") {
-		url = strings.Replace(url, "git@github.com:", "https://github.com/", 1)
-		url = strings.TrimSuffix(url, ".git")
+// ConfirmCheck asks whether to run a compliance check right after setup,
+// defaulting to yes
+func ConfirmCheck() bool {
+	prompt := promptui.Prompt{
+		Label:     "Run compliance check now",
+		IsConfirm: true,
+		Default:   "y",
 	}
 
-	return url, nil
+	_, err := prompt.Run()
+	return err == nil
 }
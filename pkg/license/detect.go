@@ -0,0 +1,108 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"regexp"
+	"strings"
+)
+
+// matchThreshold is the minimum word overlap between a file and a bundled
+// text for the file to count as that license. It tolerates reflowed text and
+// small edits while keeping close relatives such as BSD-2-Clause and
+// BSD-3-Clause apart.
+const matchThreshold = 0.95
+
+// titleMarkers identify licenses whose full text is too long to bundle by
+// the title they open with. GPL-3.0 text alone does not say "or later", so
+// it is reported as GPL-3.0-only, the identifier SPDX gives the bare text.
+var titleMarkers = []struct {
+	id    string
+	title string
+}{
+	{"GPL-3.0-only", "gnu general public license version 3 29 june 2007"},
+	{"MPL-2.0", "mozilla public license version 2 0"},
+}
+
+// nonWord splits license text into words for comparison
+var nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+
+// placeholder matches the fields left blank in bundled templates
+var placeholder = regexp.MustCompile(`<[^>]*>`)
+
+// Detect identifies the license in text, returning its SPDX identifier.
+// Bundled texts are compared by word overlap, ignoring case, punctuation,
+// layout and copyright lines; a few longer licenses are recognized by their
+// title instead.
+func Detect(text string) (string, bool) {
+	words := normalizeWords(text)
+	if len(words) == 0 {
+		return "", false
+	}
+
+	opening := strings.Join(words[:min(len(words), 12)], " ")
+	for _, marker := range titleMarkers {
+		if strings.HasPrefix(opening, marker.title) {
+			return marker.id, true
+		}
+	}
+
+	best, bestScore := "", 0.0
+	for _, id := range TextIDs() {
+		template, ok := Text(id)
+		if !ok {
+			continue
+		}
+		if score := dice(words, normalizeWords(template)); score > bestScore {
+			best, bestScore = id, score
+		}
+	}
+	if bestScore < matchThreshold {
+		return "", false
+	}
+	return best, true
+}
+
+// normalizeWords lowercases text and splits it into words, dropping
+// copyright lines and template placeholders such as <year>, which differ
+// from project to project
+func normalizeWords(text string) []string {
+	var words []string
+	for _, line := range strings.Split(strings.ToLower(text), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "copyright") || strings.HasPrefix(trimmed, "(c)") {
+			continue
+		}
+		trimmed = placeholder.ReplaceAllString(trimmed, " ")
+		for _, word := range nonWord.Split(trimmed, -1) {
+			if word != "" {
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// dice is the Sørensen–Dice coefficient of the distinct words in a and b
+func dice(a, b []string) float64 {
+	setA, setB := wordSet(a), wordSet(b)
+	if len(setA)+len(setB) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range setA {
+		if setB[word] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(setA)+len(setB))
+}
+
+func wordSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
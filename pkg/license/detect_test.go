@@ -0,0 +1,48 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLicense_Detect(t *testing.T) {
+	mit, _ := Text("MIT")
+	apache, _ := Text("Apache-2.0")
+	bsd2, _ := Text("BSD-2-Clause")
+	bsd3, _ := Text("BSD-3-Clause")
+
+	// Projects fill in the copyright line and often drop Apache's appendix
+	filledMIT := strings.Replace(mit, "Copyright (c) <year> <copyright holders>", "Copyright (c) 2025 Acme Corp.", 1)
+	apacheBody := apache[:strings.Index(apache, "APPENDIX")]
+	reflowed := strings.Join(strings.Fields(apache), " ")
+
+	tests := []struct {
+		name   string
+		text   string
+		wantID string
+	}{
+		{"apache", apache, "Apache-2.0"},
+		{"apache without appendix", apacheBody, "Apache-2.0"},
+		{"apache reflowed", reflowed, "Apache-2.0"},
+		{"mit with copyright", filledMIT, "MIT"},
+		{"bsd 2-clause", bsd2, "BSD-2-Clause"},
+		{"bsd 3-clause", bsd3, "BSD-3-Clause"},
+		{"gpl 3", "                    GNU GENERAL PUBLIC LICENSE\n                       Version 3, 29 June 2007\n\n Copyright (C) 2007 Free Software Foundation, Inc.\n", "GPL-3.0-only"},
+		{"lgpl 3 is not gpl", "GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007\n", ""},
+		{"mpl 2", "Mozilla Public License Version 2.0\n==================================\n\n1. Definitions\n", "MPL-2.0"},
+		{"unknown", "All rights reserved. Do not copy, modify or distribute this software.\n", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := Detect(tt.text)
+			if ok != (tt.wantID != "") || id != tt.wantID {
+				t.Errorf("Detect() = %q, %v, want %q", id, ok, tt.wantID)
+			}
+		})
+	}
+}
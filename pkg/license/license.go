@@ -0,0 +1,105 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package license provides offline SPDX license data: a curated list of
+// identifiers and the full text of common licenses for matching
+package license
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+//go:embed data/licenses.json data/texts/*.txt
+var data embed.FS
+
+// License describes one SPDX license identifier
+type License struct {
+	ID          string `json:"licenseId"`
+	Name        string `json:"name"`
+	OSIApproved bool   `json:"isOsiApproved"`
+	Deprecated  bool   `json:"isDeprecatedLicenseId"`
+}
+
+// list is the embedded identifier list, in the layout of the SPDX
+// licenses.json it was curated from
+type list struct {
+	Version  string    `json:"licenseListVersion"`
+	Licenses []License `json:"licenses"`
+}
+
+// bundled is decoded once at startup; the embedded data is fixed at build
+// time, so a decoding failure is a packaging bug
+var bundled = mustLoad()
+
+func mustLoad() list {
+	raw, err := data.ReadFile("data/licenses.json")
+	if err != nil {
+		panic(fmt.Sprintf("license: embedded data missing: %v", err))
+	}
+	var l list
+	if err := json.Unmarshal(raw, &l); err != nil {
+		panic(fmt.Sprintf("license: embedded data is invalid: %v", err))
+	}
+	sort.Slice(l.Licenses, func(i, j int) bool {
+		return strings.ToLower(l.Licenses[i].ID) < strings.ToLower(l.Licenses[j].ID)
+	})
+	return l
+}
+
+// ListVersion returns the SPDX license list release the bundled data
+// reflects, such as 3.25
+func ListVersion() string {
+	return bundled.Version
+}
+
+// ListLicenses returns the bundled licenses sorted by identifier
+func ListLicenses() []License {
+	licenses := make([]License, len(bundled.Licenses))
+	copy(licenses, bundled.Licenses)
+	return licenses
+}
+
+// Lookup finds a license by SPDX identifier. Matching is case-insensitive,
+// as in SPDX expressions; the returned ID has the canonical casing.
+func Lookup(id string) (License, bool) {
+	for _, l := range bundled.Licenses {
+		if strings.EqualFold(l.ID, id) {
+			return l, true
+		}
+	}
+	return License{}, false
+}
+
+// Text returns the bundled full text of a license, if it is one of the
+// few licenses whose text is included for matching
+func Text(id string) (string, bool) {
+	l, ok := Lookup(id)
+	if !ok {
+		return "", false
+	}
+	text, err := data.ReadFile(path.Join("data", "texts", l.ID+".txt"))
+	if err != nil {
+		return "", false
+	}
+	return string(text), true
+}
+
+// TextIDs returns the identifiers of the licenses whose full text is
+// bundled, sorted
+func TextIDs() []string {
+	entries, err := data.ReadDir("data/texts")
+	if err != nil {
+		return nil
+	}
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, strings.TrimSuffix(entry.Name(), ".txt"))
+	}
+	sort.Strings(ids)
+	return ids
+}
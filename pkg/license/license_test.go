@@ -0,0 +1,92 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLicense_ListLicenses(t *testing.T) {
+	if ListVersion() == "" {
+		t.Error("ListVersion() is empty")
+	}
+
+	licenses := ListLicenses()
+	if len(licenses) == 0 {
+		t.Fatal("ListLicenses() returned no licenses")
+	}
+
+	seen := make(map[string]bool)
+	for i, l := range licenses {
+		if l.ID == "" || l.Name == "" {
+			t.Errorf("license %d has an empty ID or name: %+v", i, l)
+		}
+		if seen[strings.ToLower(l.ID)] {
+			t.Errorf("duplicate identifier %s", l.ID)
+		}
+		seen[strings.ToLower(l.ID)] = true
+		if i > 0 && strings.ToLower(licenses[i-1].ID) > strings.ToLower(l.ID) {
+			t.Errorf("licenses not sorted: %s before %s", licenses[i-1].ID, l.ID)
+		}
+	}
+
+	// Callers must not be able to change the bundled data
+	licenses[0].ID = "changed"
+	if ListLicenses()[0].ID == "changed" {
+		t.Error("ListLicenses() exposes the bundled slice")
+	}
+}
+
+func TestLicense_Lookup(t *testing.T) {
+	tests := []struct {
+		id         string
+		wantID     string
+		wantOSI    bool
+		wantDeprec bool
+		wantFound  bool
+	}{
+		{"Apache-2.0", "Apache-2.0", true, false, true},
+		{"apache-2.0", "Apache-2.0", true, false, true},
+		{"GPL-2.0", "GPL-2.0", true, true, true},
+		{"CC0-1.0", "CC0-1.0", false, false, true},
+		{"Not-A-License", "", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			l, ok := Lookup(tt.id)
+			if ok != tt.wantFound {
+				t.Fatalf("Lookup(%q) found = %v, want %v", tt.id, ok, tt.wantFound)
+			}
+			if l.ID != tt.wantID || l.OSIApproved != tt.wantOSI || l.Deprecated != tt.wantDeprec {
+				t.Errorf("Lookup(%q) = %+v", tt.id, l)
+			}
+		})
+	}
+}
+
+func TestLicense_Text(t *testing.T) {
+	ids := TextIDs()
+	if len(ids) == 0 {
+		t.Fatal("TextIDs() returned no licenses")
+	}
+
+	for _, id := range ids {
+		if _, ok := Lookup(id); !ok {
+			t.Errorf("bundled text %s is not in the identifier list", id)
+		}
+		text, ok := Text(strings.ToLower(id))
+		if !ok || strings.TrimSpace(text) == "" {
+			t.Errorf("Text(%q) is missing", id)
+		}
+	}
+
+	if text, _ := Text("MIT"); !strings.Contains(text, "Permission is hereby granted") {
+		t.Error("MIT text does not contain its grant")
+	}
+	if _, ok := Text("GPL-3.0-only"); ok {
+		t.Error("Text() returned a license whose text is not bundled")
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileName is the name of the manifest file written to the repository root
+const FileName = ".baseline-manifest.json"
+
+// Manifest records which files baseline-init generated and their content hashes
+type Manifest struct {
+	GeneratedAt string      `json:"generated_at"`
+	Config      interface{} `json:"config,omitempty"`
+	Files       []File      `json:"files"`
+}
+
+// File describes a single generated file
+type File struct {
+	Path   string `json:"path"` // relative to the repository root
+	SHA256 string `json:"sha256"`
+}
+
+// New creates a new Manifest for the given generation config
+func New(config interface{}) *Manifest {
+	return &Manifest{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Config:      config,
+		Files:       []File{},
+	}
+}
+
+// Load reads the manifest from the repository root
+func Load(repoPath string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(repoPath, FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return &m, nil
+}
+
+// AddFile hashes the file at path and records it, replacing any previous entry
+func (m *Manifest) AddFile(repoPath, path string) error {
+	rel, err := filepath.Rel(repoPath, path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+	rel = filepath.ToSlash(rel)
+
+	sum, err := hashFile(path)
+	if err != nil {
+		return err
+	}
+
+	for i := range m.Files {
+		if m.Files[i].Path == rel {
+			m.Files[i].SHA256 = sum
+			return nil
+		}
+	}
+
+	m.Files = append(m.Files, File{Path: rel, SHA256: sum})
+	return nil
+}
+
+// Write saves the manifest to the repository root
+func (m *Manifest) Write(repoPath string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	return os.WriteFile(filepath.Join(repoPath, FileName), append(data, '\n'), 0644)
+}
+
+// Drifted returns the recorded files whose content no longer matches the
+// manifest, including files that have been removed
+func (m *Manifest) Drifted(repoPath string) []string {
+	drifted := []string{}
+	for _, f := range m.Files {
+		sum, err := hashFile(filepath.Join(repoPath, filepath.FromSlash(f.Path)))
+		if err != nil || sum != f.SHA256 {
+			drifted = append(drifted, f.Path)
+		}
+	}
+	return drifted
+}
+
+// hashFile returns the hex-encoded SHA-256 of a file's content
+func hashFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files with the given contents below dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+}
+
+// sha256Hex returns the hex-encoded SHA-256 of content
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestManifest_AddFile(t *testing.T) {
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"SECURITY.md":                   "policy\n",
+		".github/SECURITY-INSIGHTS.yml": "header: {}\n",
+	})
+
+	m := New(nil)
+	for _, name := range []string{"SECURITY.md", ".github/SECURITY-INSIGHTS.yml"} {
+		if err := m.AddFile(repo, filepath.Join(repo, filepath.FromSlash(name))); err != nil {
+			t.Fatalf("AddFile(%s) error = %v", name, err)
+		}
+	}
+
+	// Adding a file again replaces its hash instead of duplicating it
+	writeFiles(t, repo, map[string]string{"SECURITY.md": "updated policy\n"})
+	if err := m.AddFile(repo, filepath.Join(repo, "SECURITY.md")); err != nil {
+		t.Fatalf("AddFile(SECURITY.md) error = %v", err)
+	}
+
+	want := []File{
+		{Path: "SECURITY.md", SHA256: sha256Hex("updated policy\n")},
+		{Path: ".github/SECURITY-INSIGHTS.yml", SHA256: sha256Hex("header: {}\n")},
+	}
+	if !reflect.DeepEqual(m.Files, want) {
+		t.Errorf("Files = %+v, want %+v", m.Files, want)
+	}
+
+	if err := m.AddFile(repo, filepath.Join(repo, "LICENSE")); err == nil {
+		t.Error("AddFile() of a missing file succeeded")
+	}
+}
+
+func TestManifest_Drifted(t *testing.T) {
+	tests := []struct {
+		name   string
+		change map[string]string
+		remove []string
+		want   []string
+	}{
+		{name: "unchanged", want: []string{}},
+		{name: "edited", change: map[string]string{"SECURITY.md": "edited\n"}, want: []string{"SECURITY.md"}},
+		{name: "removed", remove: []string{"docs/GOVERNANCE.md"}, want: []string{"docs/GOVERNANCE.md"}},
+		{
+			name:   "edited and removed",
+			change: map[string]string{"docs/GOVERNANCE.md": "edited\n"},
+			remove: []string{"SECURITY.md"},
+			want:   []string{"SECURITY.md", "docs/GOVERNANCE.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := t.TempDir()
+			writeFiles(t, repo, map[string]string{"SECURITY.md": "policy\n", "docs/GOVERNANCE.md": "governance\n"})
+
+			m := New(nil)
+			for _, name := range []string{"SECURITY.md", "docs/GOVERNANCE.md"} {
+				if err := m.AddFile(repo, filepath.Join(repo, filepath.FromSlash(name))); err != nil {
+					t.Fatalf("AddFile(%s) error = %v", name, err)
+				}
+			}
+
+			writeFiles(t, repo, tt.change)
+			for _, name := range tt.remove {
+				if err := os.Remove(filepath.Join(repo, filepath.FromSlash(name))); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := m.Drifted(repo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Drifted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManifest_WriteLoad(t *testing.T) {
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{"SECURITY.md": "policy\n"})
+
+	m := New(map[string]string{"project_name": "example"})
+	if err := m.AddFile(repo, filepath.Join(repo, "SECURITY.md")); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+	if err := m.Write(repo); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	loaded, err := Load(repo)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.GeneratedAt != m.GeneratedAt || !reflect.DeepEqual(loaded.Files, m.Files) {
+		t.Errorf("Load() = %+v, want %+v", loaded, m)
+	}
+	if len(loaded.Drifted(repo)) != 0 {
+		t.Errorf("Drifted() after a round trip = %v, want none", loaded.Drifted(repo))
+	}
+
+	// A corrupt manifest is reported rather than treated as empty
+	writeFiles(t, repo, map[string]string{FileName: "{"})
+	if _, err := Load(repo); err == nil || !strings.Contains(err.Error(), "invalid manifest") {
+		t.Errorf("Load() of a corrupt manifest error = %v, want invalid manifest", err)
+	}
+	if _, err := Load(t.TempDir()); !os.IsNotExist(err) {
+		t.Errorf("Load() without a manifest error = %v, want not exist", err)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migrate upgrades SECURITY-INSIGHTS.yml files from schema 1.0.0 to
+// 2.0.0. Fields with a 2.0.0 counterpart are carried over; the rest are
+// reported as warnings for the maintainer to move by hand.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aguamala/baseline-init/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+// TargetVersion is the schema version Migrate writes
+const TargetVersion = "2.0.0"
+
+// documentComment is written above the migrated document
+const documentComment = `# OpenSSF Security Insights
+# Schema version 2.0.0, migrated from 1.0.0
+# For more information, see: https://github.com/ossf/security-insights-spec
+
+`
+
+// mappedFields are the 1.0.0 fields Migrate carries over, by section. Any
+// other field in the input is reported as not migrated.
+var mappedFields = map[string][]string{
+	"header":                  {"schema-version", "expiration-date", "last-updated", "last-reviewed", "project-url"},
+	"project-lifecycle":       {"status", "bug-fixes-only"},
+	"contribution-policy":     {"accepts-pull-requests", "accepts-automated-pull-requests"},
+	"security-contacts":       nil,
+	"vulnerability-reporting": {"accepts-vulnerability-reports"},
+}
+
+// The types below mirror the parts of the Security Insights 2.0.0 schema
+// Migrate writes
+
+type document struct {
+	Header     header     `yaml:"header"`
+	Project    project    `yaml:"project"`
+	Repository repository `yaml:"repository"`
+}
+
+type header struct {
+	SchemaVersion string `yaml:"schema-version"`
+	LastUpdated   string `yaml:"last-updated,omitempty"`
+	LastReviewed  string `yaml:"last-reviewed,omitempty"`
+	URL           string `yaml:"url"`
+}
+
+type project struct {
+	Name                   string        `yaml:"name"`
+	Administrators         []contact     `yaml:"administrators,omitempty"`
+	VulnerabilityReporting vulnReporting `yaml:"vulnerability-reporting"`
+}
+
+type vulnReporting struct {
+	ReportsAccepted    bool     `yaml:"reports-accepted"`
+	BugBountyAvailable bool     `yaml:"bug-bounty-available"`
+	Contact            *contact `yaml:"contact,omitempty"`
+	SecurityPolicy     string   `yaml:"security-policy,omitempty"`
+}
+
+type contact struct {
+	Name    string `yaml:"name"`
+	Email   string `yaml:"email,omitempty"`
+	Primary bool   `yaml:"primary"`
+}
+
+type repository struct {
+	URL                           string `yaml:"url"`
+	Status                        string `yaml:"status"`
+	AcceptsChangeRequest          bool   `yaml:"accepts-change-request"`
+	AcceptsAutomatedChangeRequest bool   `yaml:"accepts-automated-change-request"`
+	BugFixesOnly                  bool   `yaml:"bug-fixes-only"`
+}
+
+// Migrate converts a 1.0.0 document to 2.0.0, returning the new document and
+// warnings about fields that were dropped or need review. It fails when data
+// is not valid YAML or does not declare schema 1.x.
+func Migrate(data []byte) ([]byte, []string, error) {
+	var v1 validator.SecurityInsightsV1
+	if err := yaml.Unmarshal(data, &v1); err != nil {
+		return nil, nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	version := v1.Header.SchemaVersion
+	if !strings.HasPrefix(version, "1.") {
+		if version == "" {
+			return nil, nil, fmt.Errorf("header.schema-version is missing; only 1.x files can be migrated")
+		}
+		return nil, nil, fmt.Errorf("schema %s cannot be migrated; only 1.x files can be", version)
+	}
+
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+
+	doc := document{
+		Header: header{
+			SchemaVersion: TargetVersion,
+			URL:           v1.Header.ProjectURL,
+		},
+		Project: project{
+			Name: projectName(v1.Header.ProjectURL),
+			VulnerabilityReporting: vulnReporting{
+				ReportsAccepted: v1.VulnerabilityReporting.AcceptsVulnerabilityReports,
+			},
+		},
+		Repository: repository{
+			URL:                           v1.Header.ProjectURL,
+			Status:                        v1.ProjectLifecycle.Status,
+			AcceptsChangeRequest:          v1.ContributionPolicy.AcceptsPullRequests,
+			AcceptsAutomatedChangeRequest: v1.ContributionPolicy.AcceptsAutomatedPullRequests,
+			BugFixesOnly:                  v1.ProjectLifecycle.BugFixesOnly,
+		},
+	}
+
+	if v1.Header.ProjectURL == "" {
+		warn("header.project-url is missing; set header.url and repository.url by hand")
+	} else if doc.Project.Name != "" {
+		warn("project.name was set to %q from header.project-url; check it is the project's name", doc.Project.Name)
+	} else {
+		warn("project.name could not be derived from header.project-url; set it by hand")
+	}
+
+	var err error
+	if doc.Header.LastUpdated, err = reformatDate(v1.Header.LastUpdated); err != nil {
+		warn("header.last-updated: %v; set it by hand", err)
+	}
+	if doc.Header.LastReviewed, err = reformatDate(v1.Header.LastReviewed); err != nil {
+		warn("header.last-reviewed: %v; set it by hand", err)
+	}
+	if v1.Header.ExpirationDate != "" {
+		warn("header.expiration-date was dropped; schema 2.0.0 has no expiration date, so keep header.last-reviewed current instead")
+	}
+
+	for i, c := range v1.SecurityContacts {
+		field := fmt.Sprintf("security-contacts[%d]", i)
+		switch strings.ToLower(c.Type) {
+		case "email":
+			if doc.Project.VulnerabilityReporting.Contact != nil {
+				warn("%s: only the first email contact is migrated; %s was dropped", field, c.Value)
+				continue
+			}
+			security := contact{Name: "Security contact", Email: c.Value, Primary: true}
+			doc.Project.VulnerabilityReporting.Contact = &security
+			doc.Project.Administrators = []contact{security}
+			warn("project.administrators lists %s as a placeholder; replace it with the project's administrators by name", c.Value)
+		case "url":
+			if doc.Project.VulnerabilityReporting.SecurityPolicy != "" {
+				warn("%s: only the first url contact is migrated; %s was dropped", field, c.Value)
+				continue
+			}
+			doc.Project.VulnerabilityReporting.SecurityPolicy = c.Value
+			warn("project.vulnerability-reporting.security-policy was set to %s; check it links to the security policy", c.Value)
+		default:
+			warn("%s: %s contacts have no 2.0.0 counterpart; %s was dropped", field, c.Type, c.Value)
+		}
+	}
+	if doc.Project.VulnerabilityReporting.Contact == nil {
+		warn("project.administrators is empty; no email security contact was found to fill it")
+	}
+
+	unmapped, err := unmappedFields(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, field := range unmapped {
+		warn("%s has no automatic 2.0.0 mapping and was dropped; move it by hand", field)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(documentComment)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	return buf.Bytes(), warnings, nil
+}
+
+// reformatDate converts an RFC 3339 timestamp to the YYYY-MM-DD dates of
+// schema 2.0.0. Dates already in that form are kept; missing or unrecognized
+// dates are an error.
+func reformatDate(value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("missing")
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Format(time.DateOnly), nil
+	}
+	if _, err := time.Parse(time.DateOnly, value); err == nil {
+		return value, nil
+	}
+	return "", fmt.Errorf("unrecognized date %q", value)
+}
+
+// projectName derives a project name from the last path segment of a
+// repository URL such as https://github.com/owner/name
+func projectName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	name := path.Base(strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// unmappedFields lists the fields of data, as section or section.field, that
+// Migrate does not carry over
+func unmappedFields(data []byte) ([]string, error) {
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	var fields []string
+	for section, value := range raw {
+		known, ok := mappedFields[section]
+		if !ok {
+			fields = append(fields, section)
+			continue
+		}
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for field := range nested {
+			if !slices.Contains(known, field) {
+				fields = append(fields, section+"."+field)
+			}
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
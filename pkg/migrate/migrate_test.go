@@ -0,0 +1,148 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+// v1Fixture is the minimal valid 1.0.0 file from the validator tests
+const v1Fixture = `header:
+  schema-version: '1.0.0'
+  expiration-date: '2026-12-31T23:59:59Z'
+  last-updated: '2025-01-01T00:00:00Z'
+  last-reviewed: '2025-01-01T00:00:00Z'
+  project-url: https://github.com/example/repo
+
+project-lifecycle:
+  status: active
+  bug-fixes-only: false
+
+contribution-policy:
+  accepts-pull-requests: true
+  accepts-automated-pull-requests: true
+
+security-contacts:
+  - type: email
+    value: security@example.com
+
+vulnerability-reporting:
+  accepts-vulnerability-reports: true
+`
+
+func TestMigrate(t *testing.T) {
+	migrated, warnings, err := Migrate([]byte(v1Fixture))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	result, err := validator.New().ValidateSecurityInsights(migrated)
+	if err != nil {
+		t.Fatalf("ValidateSecurityInsights() error = %v", err)
+	}
+	if !result.IsValid || result.SchemaVersion != TargetVersion {
+		t.Errorf("migrated file: valid = %v, schema = %s, errors = %v\n%s",
+			result.IsValid, result.SchemaVersion, result.Errors, migrated)
+	}
+
+	var doc document
+	if err := yaml.Unmarshal(migrated, &doc); err != nil {
+		t.Fatalf("migrated file does not parse: %v", err)
+	}
+	if doc.Header.URL != "https://github.com/example/repo" || doc.Repository.URL != doc.Header.URL {
+		t.Errorf("header.url = %s, repository.url = %s", doc.Header.URL, doc.Repository.URL)
+	}
+	if doc.Header.LastUpdated != "2025-01-01" || doc.Header.LastReviewed != "2025-01-01" {
+		t.Errorf("dates = %s, %s, want 2025-01-01", doc.Header.LastUpdated, doc.Header.LastReviewed)
+	}
+	if doc.Repository.Status != "active" || !doc.Repository.AcceptsChangeRequest || !doc.Repository.AcceptsAutomatedChangeRequest {
+		t.Errorf("repository = %+v", doc.Repository)
+	}
+	vuln := doc.Project.VulnerabilityReporting
+	if !vuln.ReportsAccepted || vuln.Contact == nil || vuln.Contact.Email != "security@example.com" {
+		t.Errorf("vulnerability-reporting = %+v", vuln)
+	}
+	if len(doc.Project.Administrators) != 1 || doc.Project.Name != "repo" {
+		t.Errorf("project = %+v", doc.Project)
+	}
+
+	if !hasWarning(warnings, "expiration-date") {
+		t.Errorf("warnings = %v, want one about header.expiration-date", warnings)
+	}
+}
+
+func TestMigrate_Warnings(t *testing.T) {
+	input := `header:
+  schema-version: 1.0.0
+  last-updated: 2025-01-01T00:00:00Z
+  last-reviewed: 2025-01-01
+  project-url: https://github.com/example/repo
+project-lifecycle:
+  status: active
+security-contacts:
+  - type: email
+    value: security@example.com
+  - type: phone
+    value: "+1 555 0100"
+  - type: url
+    value: https://example.com/security
+vulnerability-reporting:
+  accepts-vulnerability-reports: true
+  comment: triaged weekly
+dependencies:
+  third-party-packages: true
+`
+	migrated, warnings, err := Migrate([]byte(input))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"security-contacts[1]: phone",
+		"security-policy was set to https://example.com/security",
+		"vulnerability-reporting.comment has no automatic 2.0.0 mapping",
+		"dependencies has no automatic 2.0.0 mapping",
+	} {
+		if !hasWarning(warnings, want) {
+			t.Errorf("warnings = %v, want one containing %q", warnings, want)
+		}
+	}
+	if !strings.Contains(string(migrated), "security-policy: https://example.com/security") {
+		t.Errorf("migrated file lacks the security policy:\n%s", migrated)
+	}
+}
+
+func TestMigrate_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "invalid YAML", input: "header: [", wantErr: "invalid YAML"},
+		{name: "already 2.0.0", input: "header:\n  schema-version: 2.0.0\n", wantErr: "schema 2.0.0 cannot be migrated"},
+		{name: "no version", input: "header:\n  project-url: https://example.com\n", wantErr: "schema-version is missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Migrate([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Migrate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func hasWarning(warnings []string, substr string) bool {
+	for _, w := range warnings {
+		if strings.Contains(w, substr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package normalize rewrites SECURITY-INSIGHTS.yml files into a canonical
+// layout: keys in specification order, block style, consistent indentation
+// and quoting only where YAML requires it. Comments and keys unknown to the
+// schema are kept.
+package normalize
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/validator"
+	sitooling "github.com/ossf/si-tooling/v2/si"
+	"gopkg.in/yaml.v3"
+)
+
+// specOrder lists key order for si-tooling types whose struct field order
+// differs from the order used in the specification's examples. Other types
+// follow their struct field order.
+var specOrder = map[string][]string{
+	"Header":     {"schema-version", "last-updated", "last-reviewed", "url", "project-si-source", "comment"},
+	"Contact":    {"name", "affiliation", "email", "social", "primary"},
+	"Assessment": {"name", "evidence", "date", "comment"},
+	"VulnReport": {"reports-accepted", "bug-bounty-available", "bug-bounty-program", "contact",
+		"security-policy", "pgp-key", "in-scope", "out-of-scope", "comment"},
+	"Repository": {"url", "status", "bug-fixes-only", "accepts-change-request", "accepts-automated-change-request",
+		"no-third-party-packages", "core-team", "documentation", "license", "release", "security"},
+}
+
+// siToolingPkg is the import path of the v2 schema types
+var siToolingPkg = reflect.TypeOf(sitooling.SecurityInsights{}).PkgPath()
+
+// Normalize returns data in canonical form. It fails rather than return
+// output whose decoded content differs from the input.
+func Normalize(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	root := doc.Content[0]
+	var leading string
+	if root.Kind == yaml.MappingNode && len(root.Content) > 0 {
+		// A comment at the top of the file belongs to the document, not to
+		// whichever key happens to come first
+		leading, root.Content[0].HeadComment = root.Content[0].HeadComment, ""
+	}
+	reorder(root, schemaType(root))
+	restyle(root)
+	if leading != "" {
+		root.Content[0].HeadComment = leading
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to encode: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode: %w", err)
+	}
+
+	if err := sameContent(data, buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// schemaType picks the struct describing the document's schema version,
+// whose field order defines the canonical key order
+func schemaType(root *yaml.Node) reflect.Type {
+	var probe struct {
+		Header struct {
+			SchemaVersion string `yaml:"schema-version"`
+		} `yaml:"header"`
+	}
+	_ = root.Decode(&probe)
+
+	if strings.HasPrefix(probe.Header.SchemaVersion, "1.") {
+		return reflect.TypeOf(validator.SecurityInsightsV1{})
+	}
+	return reflect.TypeOf(sitooling.SecurityInsights{})
+}
+
+// reorder sorts mapping keys into the field order of t, recursing into
+// nested structs and slices. Keys t does not declare keep their relative
+// order after the known ones.
+func reorder(node *yaml.Node, t reflect.Type) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch node.Kind {
+	case yaml.SequenceNode:
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		for _, item := range node.Content {
+			reorder(item, elem)
+		}
+
+	case yaml.MappingNode:
+		fields := map[string]reflect.Type{}
+		order := map[string]int{}
+		if t != nil && t.Kind() == reflect.Struct {
+			for i := 0; i < t.NumField(); i++ {
+				name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+				if name == "" || name == "-" {
+					continue
+				}
+				fields[name] = t.Field(i).Type
+				order[name] = i
+			}
+			if keys, ok := specOrder[t.Name()]; ok && t.PkgPath() == siToolingPkg {
+				for i, key := range keys {
+					order[key] = i
+				}
+			}
+		}
+
+		type pair struct{ key, value *yaml.Node }
+		pairs := make([]pair, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			pairs = append(pairs, pair{node.Content[i], node.Content[i+1]})
+		}
+
+		rank := func(key string) int {
+			if i, ok := order[key]; ok {
+				return i
+			}
+			return len(order)
+		}
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return rank(pairs[i].key.Value) < rank(pairs[j].key.Value)
+		})
+
+		node.Content = node.Content[:0]
+		for _, p := range pairs {
+			reorder(p.value, fields[p.key.Value])
+			node.Content = append(node.Content, p.key, p.value)
+		}
+	}
+}
+
+// restyle resets presentation choices so equal documents print the same:
+// block collections, plain scalars (the encoder still quotes where needed)
+// and literal blocks for multi-line strings
+func restyle(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if strings.Contains(node.Value, "\n") && node.Tag == "!!str" {
+			node.Style = yaml.LiteralStyle
+		} else {
+			node.Style = 0
+		}
+	case yaml.MappingNode, yaml.SequenceNode:
+		node.Style = 0
+	}
+
+	for _, child := range node.Content {
+		restyle(child)
+	}
+}
+
+// sameContent verifies that normalization changed presentation only
+func sameContent(before, after []byte) error {
+	var a, b interface{}
+	if err := yaml.Unmarshal(before, &a); err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+	if err := yaml.Unmarshal(after, &b); err != nil {
+		return fmt.Errorf("normalized output is not valid YAML: %w", err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		return fmt.Errorf("normalization would change the file's content; leaving it untouched")
+	}
+	return nil
+}
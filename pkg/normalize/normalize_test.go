@@ -0,0 +1,122 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package normalize
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "v2 keys in spec order with flow style expanded",
+			input: `# Project insights
+repository:
+    status: "active"
+    url: 'https://github.com/example/repo'
+    core-team: [{primary: true, name: Alice}]
+header:
+    url: https://github.com/example/repo
+    schema-version: "2.0.0"
+`,
+			want: `# Project insights
+header:
+  schema-version: 2.0.0
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+  core-team:
+    - name: Alice
+      primary: true
+`,
+		},
+		{
+			name: "unknown keys and comments are kept",
+			input: `header:
+  x-internal: yes   # kept
+  schema-version: 2.0.0
+`,
+			want: `header:
+  schema-version: 2.0.0
+  x-internal: yes # kept
+`,
+		},
+		{
+			name: "strings that look like other types stay quoted",
+			input: `header:
+  schema-version: 2.0.0
+  last-updated: '2025-01-02'
+project:
+  name: '007'
+`,
+			want: `header:
+  schema-version: 2.0.0
+  last-updated: "2025-01-02"
+project:
+  name: "007"
+`,
+		},
+		{
+			name: "v1 uses its own order",
+			input: `project-lifecycle:
+  status: active
+header:
+  project-url: https://github.com/example/repo
+  schema-version: 1.0.0
+`,
+			want: `header:
+  schema-version: 1.0.0
+  project-url: https://github.com/example/repo
+project-lifecycle:
+  status: active
+`,
+		},
+		{
+			name:    "invalid YAML",
+			input:   "header: [unclosed\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("Normalize() =\n%s\nwant:\n%s", got, tt.want)
+			}
+
+			again, err := Normalize(got)
+			if err != nil {
+				t.Fatalf("second Normalize() error = %v", err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("Normalize() is not idempotent:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestNormalize_MultilineStrings(t *testing.T) {
+	got, err := Normalize([]byte("header:\n  comment: \"line one\\nline two\\n\"\n"))
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+	if !strings.Contains(string(got), "comment: |\n    line one\n    line two\n") {
+		t.Errorf("multi-line string not emitted as a literal block:\n%s", got)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+const barWidth = 30
+
+// Bar is a single-line progress indicator for multi-repository operations.
+// It draws on stderr so it never mixes with report output on stdout, and is
+// disabled when stderr is not a terminal.
+type Bar struct {
+	out     io.Writer
+	enabled bool
+	total   int
+	current int
+	drawn   bool
+}
+
+// New creates a Bar for total items. It is disabled when quiet is set or
+// stderr is not a terminal.
+func New(total int, quiet bool) *Bar {
+	return &Bar{
+		out:     os.Stderr,
+		enabled: !quiet && isatty.IsTerminal(os.Stderr.Fd()),
+		total:   total,
+	}
+}
+
+// Increment marks one more item as processed and redraws the bar
+func (b *Bar) Increment(label string) {
+	b.current++
+	b.draw(label)
+}
+
+// Clear erases the bar so other output can be written on a clean line. The
+// next Increment redraws it.
+func (b *Bar) Clear() {
+	if !b.enabled || !b.drawn {
+		return
+	}
+	fmt.Fprint(b.out, "\r\033[K")
+	b.drawn = false
+}
+
+// Finish erases the bar once all items are processed
+func (b *Bar) Finish() {
+	b.Clear()
+}
+
+// draw renders the bar as "[#####.....] 12/40 label"
+func (b *Bar) draw(label string) {
+	if !b.enabled || b.total <= 0 {
+		return
+	}
+
+	filled := b.current * barWidth / b.total
+	if filled > barWidth {
+		filled = barWidth
+	}
+	fmt.Fprintf(b.out, "\r\033[K[%s%s] %d/%d %s",
+		strings.Repeat("#", filled), strings.Repeat(".", barWidth-filled),
+		b.current, b.total, label)
+	b.drawn = true
+}
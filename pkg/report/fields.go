@@ -0,0 +1,76 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+// SetFields limits JSON output to the named CheckResult fields, given by
+// their JSON names, in the order listed
+func (r *Reporter) SetFields(fields []string) {
+	r.fields = fields
+}
+
+// projectFields builds a value holding only the selected fields of result.
+// Fields are looked up by their JSON tags, so the names match the full
+// JSON output; selected fields are always included, even when empty, and a
+// field named more than once is emitted only once.
+func projectFields(result *checker.CheckResult, fields []string) (interface{}, error) {
+	v := reflect.ValueOf(result).Elem()
+	t := v.Type()
+
+	byName := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			byName[name] = i
+		}
+	}
+
+	var structFields []reflect.StructField
+	var values []reflect.Value
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if seen[field] {
+			continue
+		}
+		seen[field] = true
+		var value reflect.Value
+		if i, ok := byName[field]; ok {
+			value = v.Field(i)
+		} else {
+			return nil, fmt.Errorf("unknown field %q (available: %s)", field, strings.Join(selectableFields(byName), ", "))
+		}
+
+		structFields = append(structFields, reflect.StructField{
+			Name: fmt.Sprintf("F%d", len(structFields)),
+			Type: value.Type(),
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:%q`, field)),
+		})
+		values = append(values, value)
+	}
+
+	projected := reflect.New(reflect.StructOf(structFields)).Elem()
+	for i, value := range values {
+		projected.Field(i).Set(value)
+	}
+	return projected.Interface(), nil
+}
+
+// selectableFields lists every name accepted by projectFields
+func selectableFields(byName map[string]int) []string {
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReporter_Fields(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		fields  []string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "selected fields in order",
+			format: "json-compact",
+			fields: []string{"is_compliant", "path"},
+			want:   `{"is_compliant":true,"path":"example"}`,
+		},
+		{
+			name:   "surrounding spaces",
+			format: "json-compact",
+			fields: []string{" path", "score "},
+			want:   `{"path":"example","score":100}`,
+		},
+		{
+			name:   "empty fields are kept",
+			format: "json-compact",
+			fields: []string{"missing_files", "partial"},
+			want:   `{"missing_files":[],"partial":false}`,
+		},
+		{
+			name:   "duplicate field",
+			format: "json-compact",
+			fields: []string{"score", "score"},
+			want:   `{"score":100}`,
+		},
+		{
+			name:   "duplicate after trimming",
+			format: "json-compact",
+			fields: []string{"path", "score", " path"},
+			want:   `{"path":"example","score":100}`,
+		},
+		{
+			name:    "unknown field",
+			format:  "json-compact",
+			fields:  []string{"path", "compliant"},
+			wantErr: `unknown field "compliant"`,
+		},
+		{
+			name:    "non-json format",
+			format:  "yaml",
+			fields:  []string{"path"},
+			wantErr: "field selection requires json or json-compact format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter(tt.format)
+			r.SetOutput(&buf)
+			r.SetFields(tt.fields)
+			err := r.OutputCheckResult(compliantResult())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("OutputCheckResult() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OutputCheckResult() error = %v", err)
+			}
+			if got := strings.TrimSpace(buf.String()); got != tt.want {
+				t.Errorf("output = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
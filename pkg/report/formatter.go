@@ -6,7 +6,10 @@ package report
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/aguamala/baseline-init/pkg/checker"
@@ -16,23 +19,113 @@ import (
 
 // Reporter handles formatting and output of compliance results
 type Reporter struct {
-	format string
+	out         io.Writer
+	format      string
+	sortBy      string
+	groupBy     string
+	teams       map[string]string
+	showLegend  bool
+	onlyMissing bool
+	fields      []string
+}
+
+// priorities lists recommendation priorities from most to least severe
+var priorities = []string{"critical", "high", "medium", "low"}
+
+// efforts lists recommendation effort levels from quickest to most involved:
+// auto can be fixed by 'baseline-init setup', manual needs a small edit and
+// policy needs a project decision
+var efforts = []string{"auto", "manual", "policy"}
+
+// SortOrders lists the orders recommendations can be sorted in
+var SortOrders = []string{"priority", "category", "effort"}
+
+// Formats lists the output formats of a single-repository report, besides
+// inline templates
+var Formats = []string{"text", "json", "json-compact", "yaml", "markdown", "md", "sarif", "github"}
+
+// MultiFormats lists the output formats of a report on several
+// repositories, besides inline templates
+var MultiFormats = []string{"text", "json", "json-compact", "yaml", "github"}
+
+// ValidateFormat reports an error unless format is one of formats or an
+// inline template that parses, so a mistyped format is caught before any
+// output is written
+func ValidateFormat(format string, formats []string) error {
+	if isTemplateFormat(format) {
+		_, err := parseTemplate(format)
+		return err
+	}
+	if !slices.Contains(formats, format) {
+		return fmt.Errorf("unsupported format %q: must be one of %s, or template=<go template>", format, strings.Join(formats, ", "))
+	}
+	return nil
 }
 
 // NewReporter creates a new Reporter instance
 func NewReporter(format string) *Reporter {
 	return &Reporter{
-		format: format,
+		out:        os.Stdout,
+		format:     format,
+		sortBy:     "priority",
+		showLegend: true,
 	}
 }
 
+// SetOutput sets where reports are written, stdout by default
+func (r *Reporter) SetOutput(w io.Writer) {
+	r.out = w
+}
+
+// SetSort sets the recommendation order: priority (default), category, or
+// effort (quick wins first)
+func (r *Reporter) SetSort(sortBy string) {
+	r.sortBy = sortBy
+}
+
+// SetShowLegend controls whether the text report starts with a symbol and
+// priority legend
+func (r *Reporter) SetShowLegend(show bool) {
+	r.showLegend = show
+}
+
+// SetOnlyMissing limits the text report to missing files and the
+// recommendations that address them
+func (r *Reporter) SetOnlyMissing(only bool) {
+	r.onlyMissing = only
+}
+
 // OutputCheckResult outputs the compliance check result
 func (r *Reporter) OutputCheckResult(result *checker.CheckResult) error {
+	sorted, err := sortRecommendations(result.Recommendations, r.sortBy)
+	if err != nil {
+		return err
+	}
+	sortedResult := *result
+	sortedResult.Recommendations = sorted
+	result = &sortedResult
+
+	if isTemplateFormat(r.format) {
+		return outputTemplate(r.out, r.format, result)
+	}
+
+	if len(r.fields) > 0 && r.format != "json" && r.format != "json-compact" {
+		return fmt.Errorf("field selection requires json or json-compact format, not %s", r.format)
+	}
+
 	switch r.format {
 	case "json":
-		return r.outputJSON(result)
+		return r.outputJSON(result, true)
+	case "json-compact":
+		return r.outputJSON(result, false)
 	case "yaml":
 		return r.outputYAML(result)
+	case "markdown", "md":
+		return r.outputMarkdown(result)
+	case "sarif":
+		return r.outputSARIF(result)
+	case "github":
+		return r.outputGitHub(result)
 	case "text":
 		return r.outputText(result)
 	default:
@@ -40,16 +133,28 @@ func (r *Reporter) OutputCheckResult(result *checker.CheckResult) error {
 	}
 }
 
-// outputJSON outputs results as JSON
-func (r *Reporter) outputJSON(result *checker.CheckResult) error {
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(result)
+// outputJSON outputs results as JSON, either pretty-printed or on a single
+// line, trimmed to the selected fields if any
+func (r *Reporter) outputJSON(result *checker.CheckResult, pretty bool) error {
+	var output interface{} = result
+	if len(r.fields) > 0 {
+		projected, err := projectFields(result, r.fields)
+		if err != nil {
+			return err
+		}
+		output = projected
+	}
+
+	encoder := json.NewEncoder(r.out)
+	if pretty {
+		encoder.SetIndent("", "  ")
+	}
+	return encoder.Encode(output)
 }
 
 // outputYAML outputs results as YAML
 func (r *Reporter) outputYAML(result *checker.CheckResult) error {
-	encoder := yaml.NewEncoder(os.Stdout)
+	encoder := yaml.NewEncoder(r.out)
 	defer encoder.Close()
 	return encoder.Encode(result)
 }
@@ -64,92 +169,232 @@ func (r *Reporter) outputText(result *checker.CheckResult) error {
 	bold := color.New(color.Bold).SprintFunc()
 
 	// Header
-	fmt.Println(bold("OpenSSF Baseline Compliance Check"))
-	fmt.Println(strings.Repeat("=", 50))
-	fmt.Printf("Repository: %s\n\n", result.Path)
+	fmt.Fprintln(r.out, bold("OpenSSF Baseline Compliance Check"))
+	fmt.Fprintln(r.out, strings.Repeat("=", 50))
+	fmt.Fprintf(r.out, "Repository: %s\n", result.Path)
+	if result.Level > 0 {
+		fmt.Fprintf(r.out, "Baseline level: %d\n", result.Level)
+	}
+	fmt.Fprintln(r.out)
+
+	if r.showLegend {
+		fmt.Fprintf(r.out, "Legend: %s present  %s missing  %s warning\n", green("✓"), red("✗"), yellow("⚠"))
+		fmt.Fprint(r.out, "Priority:")
+		for _, priority := range priorities {
+			fmt.Fprintf(r.out, " %s", priorityColor(priority)(strings.ToUpper(priority)))
+		}
+		fmt.Fprint(r.out, "\n\n")
+	}
 
 	// Overall status
 	if result.IsCompliant {
-		fmt.Printf("Status: %s\n\n", green("✓ COMPLIANT"))
+		fmt.Fprintf(r.out, "Status: %s\n", green("✓ COMPLIANT"))
 	} else {
-		fmt.Printf("Status: %s\n\n", red("✗ NOT COMPLIANT"))
+		fmt.Fprintf(r.out, "Status: %s\n", red("✗ NOT COMPLIANT"))
 	}
+	fmt.Fprintf(r.out, "Summary: %s\n", summarize(result))
+	fmt.Fprintf(r.out, "Score: %d/100\n", result.Score)
+	if result.Partial {
+		fmt.Fprintf(r.out, "%s\n", yellow("⚠ Partial results due to timeout: some checks did not run"))
+	}
+	fmt.Fprintln(r.out)
 
 	// File checks
-	fmt.Println(bold("File Checks:"))
+	fmt.Fprintln(r.out, bold("File Checks:"))
 	for _, file := range result.Files {
+		if file.Exists && r.onlyMissing {
+			continue
+		}
 		if file.Exists {
-			fmt.Printf("  %s %s\n", green("✓"), file.Name)
+			fmt.Fprintf(r.out, "  %s %s\n", green("✓"), file.Name)
 			if file.Path != "" {
-				fmt.Printf("    Location: %s\n", cyan(file.Path))
+				fmt.Fprintf(r.out, "    Location: %s\n", cyan(file.Path))
+			}
+			if file.Detected != "" {
+				fmt.Fprintf(r.out, "    License: %s\n", file.Detected)
+			}
+			for _, e := range file.Errors {
+				fmt.Fprintf(r.out, "    %s %s\n", red("✗"), e)
 			}
 			if len(file.Warnings) > 0 {
 				for _, warning := range file.Warnings {
-					fmt.Printf("    %s %s\n", yellow("⚠"), warning)
+					fmt.Fprintf(r.out, "    %s %s\n", yellow("⚠"), warning)
 				}
 			}
 		} else {
-			fmt.Printf("  %s %s\n", red("✗"), file.Name)
+			fmt.Fprintf(r.out, "  %s %s\n", red("✗"), file.Name)
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(r.out)
 
 	// Missing files
 	if len(result.MissingFiles) > 0 {
-		fmt.Println(bold("Missing Files:"))
+		fmt.Fprintln(r.out, bold("Missing Files:"))
 		for _, missing := range result.MissingFiles {
-			fmt.Printf("  %s %s\n", red("✗"), missing)
+			fmt.Fprintf(r.out, "  %s %s\n", red("✗"), missing)
 		}
-		fmt.Println()
+		fmt.Fprintln(r.out)
 	}
 
 	// Recommendations
-	if len(result.Recommendations) > 0 {
-		fmt.Println(bold("Recommendations:"))
-
-		// Group by priority
-		priorities := []string{"critical", "high", "medium", "low"}
-		for _, priority := range priorities {
-			var recs []checker.Recommendation
-			for _, rec := range result.Recommendations {
-				if rec.Priority == priority {
-					recs = append(recs, rec)
-				}
-			}
+	recommendations := result.Recommendations
+	if r.onlyMissing {
+		recommendations = missingRecommendations(result)
+	}
+	if len(recommendations) > 0 {
+		fmt.Fprintln(r.out, bold("Recommendations:"))
 
-			if len(recs) == 0 {
-				continue
+		for _, rec := range recommendations {
+			colorize := priorityColor(rec.Priority)
+			fmt.Fprintf(r.out, "\n  [%s] %s\n", colorize(strings.ToUpper(rec.Priority)), bold(rec.Description))
+			if rec.ID != "" {
+				fmt.Fprintf(r.out, "  ID: %s\n", rec.ID)
 			}
-
-			priorityColor := color.New(color.FgWhite).SprintFunc()
-			switch priority {
-			case "critical":
-				priorityColor = color.New(color.FgRed, color.Bold).SprintFunc()
-			case "high":
-				priorityColor = color.New(color.FgRed).SprintFunc()
-			case "medium":
-				priorityColor = color.New(color.FgYellow).SprintFunc()
-			case "low":
-				priorityColor = color.New(color.FgCyan).SprintFunc()
+			fmt.Fprintf(r.out, "  Category: %s\n", rec.Category)
+			if rec.Effort != "" {
+				fmt.Fprintf(r.out, "  Effort: %s\n", rec.Effort)
 			}
-
-			for _, rec := range recs {
-				fmt.Printf("\n  [%s] %s\n", priorityColor(strings.ToUpper(priority)), bold(rec.Description))
-				fmt.Printf("  Category: %s\n", rec.Category)
-				fmt.Printf("  Action: %s\n", cyan(rec.Action))
+			fmt.Fprintf(r.out, "  Action: %s\n", cyan(rec.Action))
+			if rec.Reference != "" {
+				fmt.Fprintf(r.out, "  Reference: %s\n", rec.Reference)
 			}
 		}
-		fmt.Println()
+		fmt.Fprintln(r.out)
 	}
 
 	// Summary
 	if !result.IsCompliant {
-		fmt.Println(bold("Next Steps:"))
-		fmt.Println("  1. Run 'baseline-init setup --auto' to auto-generate missing files")
-		fmt.Println("  2. Or run 'baseline-init setup --interactive' for guided setup")
-		fmt.Println("  3. Review and customize generated files")
-		fmt.Println("  4. Run 'baseline-init check' again to verify")
+		fmt.Fprintln(r.out, bold("Next Steps:"))
+		if len(recommendations) > 0 {
+			fmt.Fprintf(r.out, "  %d of %d issues can be auto-fixed with 'baseline-init setup --auto'.\n",
+				countAutoFixable(recommendations), len(recommendations))
+		}
+		fmt.Fprintln(r.out, "  1. Run 'baseline-init setup --auto' to auto-generate missing files")
+		fmt.Fprintln(r.out, "  2. Or run 'baseline-init setup --interactive' for guided setup")
+		fmt.Fprintln(r.out, "  3. Review and customize generated files")
+		fmt.Fprintln(r.out, "  4. Run 'baseline-init check' again to verify")
 	}
 
 	return nil
 }
+
+// countAutoFixable returns how many recommendations setup can resolve
+func countAutoFixable(recommendations []checker.Recommendation) int {
+	count := 0
+	for _, rec := range recommendations {
+		if rec.AutoFixable {
+			count++
+		}
+	}
+	return count
+}
+
+// priorityColor returns the color function used for a recommendation priority
+func priorityColor(priority string) func(a ...interface{}) string {
+	switch priority {
+	case "critical":
+		return color.New(color.FgRed, color.Bold).SprintFunc()
+	case "high":
+		return color.New(color.FgRed).SprintFunc()
+	case "medium":
+		return color.New(color.FgYellow).SprintFunc()
+	case "low":
+		return color.New(color.FgCyan).SprintFunc()
+	default:
+		return color.New(color.FgWhite).SprintFunc()
+	}
+}
+
+// summarize returns a one-line overview of files found and recommendations
+func summarize(result *checker.CheckResult) string {
+	present := 0
+	for _, file := range result.Files {
+		if file.Exists {
+			present++
+		}
+	}
+
+	summary := fmt.Sprintf("%d of %d files present, %d recommendation(s)",
+		present, len(result.Files), len(result.Recommendations))
+	if len(result.Recommendations) == 0 {
+		return summary
+	}
+
+	counts := []string{}
+	for _, priority := range priorities {
+		n := 0
+		for _, rec := range result.Recommendations {
+			if rec.Priority == priority {
+				n++
+			}
+		}
+		if n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", n, priority))
+		}
+	}
+	return fmt.Sprintf("%s (%s)", summary, strings.Join(counts, ", "))
+}
+
+// missingRecommendations returns the recommendations that address files which
+// do not exist, dropping advice about files that are already present
+func missingRecommendations(result *checker.CheckResult) []checker.Recommendation {
+	missing := map[string]bool{}
+	for _, file := range result.Files {
+		if !file.Exists {
+			missing[file.Name] = true
+		}
+	}
+
+	recs := []checker.Recommendation{}
+	for _, rec := range result.Recommendations {
+		if missing[rec.File] {
+			recs = append(recs, rec)
+		}
+	}
+	return recs
+}
+
+// sortRecommendations returns a copy of recs ordered by priority, category or
+// effort. Ties are broken by priority, then by original order.
+func sortRecommendations(recs []checker.Recommendation, sortBy string) ([]checker.Recommendation, error) {
+	sorted := make([]checker.Recommendation, len(recs))
+	copy(sorted, recs)
+
+	byPriority := func(i, j int) bool {
+		return rank(priorities, sorted[i].Priority) < rank(priorities, sorted[j].Priority)
+	}
+
+	switch sortBy {
+	case "", "priority":
+		sort.SliceStable(sorted, byPriority)
+	case "category":
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].Category != sorted[j].Category {
+				return sorted[i].Category < sorted[j].Category
+			}
+			return byPriority(i, j)
+		})
+	case "effort":
+		sort.SliceStable(sorted, func(i, j int) bool {
+			ei, ej := rank(efforts, sorted[i].Effort), rank(efforts, sorted[j].Effort)
+			if ei != ej {
+				return ei < ej
+			}
+			return byPriority(i, j)
+		})
+	default:
+		return nil, fmt.Errorf("unsupported sort: %s", sortBy)
+	}
+
+	return sorted, nil
+}
+
+// rank returns the position of value in order, placing unknown values last
+func rank(order []string, value string) int {
+	for i, v := range order {
+		if v == value {
+			return i
+		}
+	}
+	return len(order)
+}
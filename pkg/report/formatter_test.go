@@ -0,0 +1,555 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"gopkg.in/yaml.v3"
+)
+
+// compliantResult is a check result with every required file present and
+// one low-priority recommendation
+func compliantResult() *checker.CheckResult {
+	result := &checker.CheckResult{
+		ReportVersion: checker.ReportVersion,
+		Path:          "example",
+		IsCompliant:   true,
+		Files: []checker.FileCheck{
+			{Name: "SECURITY-INSIGHTS.yml", Path: "example/SECURITY-INSIGHTS.yml", Exists: true, Valid: true},
+			{Name: "SECURITY.md", Path: "example/SECURITY.md", Exists: true, Valid: true},
+			{Name: "LICENSE", Path: "example/LICENSE", Exists: true, Valid: true, Detected: "MIT"},
+		},
+		MissingFiles: []string{},
+		Recommendations: []checker.Recommendation{
+			{
+				ID:          checker.RecCodeOfConductMissing,
+				Priority:    "low",
+				Category:    "Community",
+				Description: "CODE_OF_CONDUCT.md file is missing",
+				Action:      "Run 'baseline-init setup --auto'",
+				Reference:   "https://www.contributor-covenant.org",
+				File:        "CODE_OF_CONDUCT.md",
+			},
+		},
+	}
+	result.UpdateScore()
+	return result
+}
+
+func TestReporter_OutputCheckResult(t *testing.T) {
+	tests := []struct {
+		format string
+		verify func(t *testing.T, out string)
+	}{
+		{
+			format: "text",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"✓ COMPLIANT", "✓ LICENSE", "License: MIT",
+					"Score: 100/100", "ID: BASELINE-COM-001", "Reference: https://www.contributor-covenant.org"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("text output does not contain %q:\n%s", want, out)
+					}
+				}
+			},
+		},
+		{
+			format: "json",
+			verify: func(t *testing.T, out string) {
+				var result checker.CheckResult
+				if err := json.Unmarshal([]byte(out), &result); err != nil {
+					t.Fatalf("output is not JSON: %v", err)
+				}
+				if !result.IsCompliant || len(result.Files) != 3 {
+					t.Errorf("decoded result = %+v", result)
+				}
+				if result.Score != 100 || result.MaxScore != 8 || result.EarnedScore != 8 {
+					t.Errorf("decoded score = %d (%d/%d), want 100 (8/8)", result.Score, result.EarnedScore, result.MaxScore)
+				}
+				if len(result.Recommendations) != 1 || result.Recommendations[0].ID != checker.RecCodeOfConductMissing ||
+					result.Recommendations[0].Reference == "" {
+					t.Errorf("decoded recommendations = %+v", result.Recommendations)
+				}
+			},
+		},
+		{
+			format: "json-compact",
+			verify: func(t *testing.T, out string) {
+				if strings.Count(out, "\n") != 1 {
+					t.Errorf("compact output spans %d lines", strings.Count(out, "\n"))
+				}
+			},
+		},
+		{
+			format: "yaml",
+			verify: func(t *testing.T, out string) {
+				var result map[string]interface{}
+				if err := yaml.Unmarshal([]byte(out), &result); err != nil {
+					t.Fatalf("output is not YAML: %v", err)
+				}
+				if result["path"] != "example" || result["score"] != 100 {
+					t.Errorf("path = %v, score = %v, want example and 100", result["path"], result["score"])
+				}
+				// Keys match the JSON names
+				if result["report_version"] != checker.ReportVersion || result["max_score"] != 8 || result["earned_score"] != 8 {
+					t.Errorf("report_version = %v, max_score = %v, earned_score = %v, want %s, 8 and 8",
+						result["report_version"], result["max_score"], result["earned_score"], checker.ReportVersion)
+				}
+				recs, _ := result["recommendations"].([]interface{})
+				if len(recs) != 1 {
+					t.Fatalf("recommendations = %v, want one", result["recommendations"])
+				}
+				rec, _ := recs[0].(map[string]interface{})
+				if rec["id"] != checker.RecCodeOfConductMissing || rec["reference"] != "https://www.contributor-covenant.org" {
+					t.Errorf("recommendation = %v, want its id and reference", rec)
+				}
+				if _, ok := rec["auto_fixable"]; !ok {
+					t.Errorf("recommendation = %v, want an auto_fixable key", rec)
+				}
+			},
+		},
+		{
+			format: "markdown",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"| Name | Exists |", "| LICENSE | ✅ | ✅ | `example/LICENSE` |"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("markdown output does not contain %q:\n%s", want, out)
+					}
+				}
+			},
+		},
+		{
+			format: "md",
+			verify: func(t *testing.T, out string) {
+				if !strings.Contains(out, "| Name | Exists |") {
+					t.Errorf("md output has no file table:\n%s", out)
+				}
+			},
+		},
+		{
+			format: "template={{.Path}}:{{.Score}}",
+			verify: func(t *testing.T, out string) {
+				if out != "example:100\n" {
+					t.Errorf("template output = %q", out)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter(tt.format)
+			r.SetOutput(&buf)
+			if err := r.OutputCheckResult(compliantResult()); err != nil {
+				t.Fatalf("OutputCheckResult() error = %v", err)
+			}
+			tt.verify(t, buf.String())
+		})
+	}
+}
+
+func TestReporter_OutputMultiResult(t *testing.T) {
+	failing := compliantResult()
+	failing.Path = "other"
+	failing.IsCompliant = false
+	failing.MissingFiles = []string{"LICENSE"}
+
+	tests := []struct {
+		name        string
+		noLegend    bool
+		onlyMissing bool
+		want        []string
+		notWant     []string
+	}{
+		{
+			name: "default",
+			want: []string{"Repositories: 2 (1 compliant, 1 not compliant)", "Legend:", "example (100%)", "Missing: LICENSE"},
+		},
+		{
+			name:     "no legend",
+			noLegend: true,
+			want:     []string{"example (100%)", "Missing: LICENSE"},
+			notWant:  []string{"Legend:"},
+		},
+		{
+			name:        "only missing",
+			onlyMissing: true,
+			want:        []string{"Repositories: 2 (1 compliant, 1 not compliant)", "other", "Missing: LICENSE"},
+			notWant:     []string{"example (100%)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter("text")
+			r.SetOutput(&buf)
+			r.SetShowLegend(!tt.noLegend)
+			r.SetOnlyMissing(tt.onlyMissing)
+			if err := r.OutputMultiResult([]*checker.CheckResult{compliantResult(), failing}); err != nil {
+				t.Fatalf("OutputMultiResult() error = %v", err)
+			}
+
+			out := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(out, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, out)
+				}
+			}
+		})
+	}
+}
+
+func TestReporter_TeamFor(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewReporter("text")
+	r.SetTeams(map[string]string{"./repos/api": "platform", "repos": "infra", "tools/scanner/": "security"})
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"repos/api", "platform"},
+		{"./repos/api/", "platform"},
+		{filepath.Join(wd, "repos", "api", "cmd"), "platform"},
+		{"repos/web", "infra"},
+		{"tools/scanner", "security"},
+		{"tools/scanner-v2", "unassigned"},
+		{"other", "unassigned"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := r.teamFor(tt.path); got != tt.want {
+				t.Errorf("teamFor(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		formats []string
+		wantErr string
+	}{
+		{format: "json", formats: Formats},
+		{format: "sarif", formats: Formats},
+		{format: "template={{.Score}}", formats: MultiFormats},
+		{format: "sarif", formats: MultiFormats, wantErr: `unsupported format "sarif"`},
+		{format: "jsno", formats: Formats, wantErr: `unsupported format "jsno"`},
+		{format: "", formats: Formats, wantErr: `unsupported format ""`},
+		{format: "template={{.Score", formats: Formats, wantErr: "invalid output template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			err := ValidateFormat(tt.format, tt.formats)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateFormat(%q) error = %v", tt.format, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateFormat(%q) error = %v, want %q", tt.format, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReporter_Legend(t *testing.T) {
+	tests := []struct {
+		name    string
+		legend  bool
+		want    []string
+		notWant []string
+	}{
+		{
+			name:   "shown by default",
+			legend: true,
+			want:   []string{"Legend: ✓ present  ✗ missing  ⚠ warning", "Priority: CRITICAL HIGH MEDIUM LOW", "Summary:"},
+		},
+		{
+			name:    "suppressed",
+			want:    []string{"Summary:"},
+			notWant: []string{"Legend:", "Priority:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter("text")
+			r.SetOutput(&buf)
+			r.SetShowLegend(tt.legend)
+			if err := r.OutputCheckResult(compliantResult()); err != nil {
+				t.Fatalf("OutputCheckResult() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, buf.String())
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(buf.String(), notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestReporter_SummaryLine(t *testing.T) {
+	rec := func(priority string) checker.Recommendation {
+		return checker.Recommendation{Priority: priority}
+	}
+
+	tests := []struct {
+		name   string
+		result *checker.CheckResult
+		want   string
+	}{
+		{
+			name:   "empty result",
+			result: &checker.CheckResult{},
+			want:   "0 of 0 files present, 0 recommendation(s)",
+		},
+		{
+			name: "all present",
+			result: &checker.CheckResult{Files: []checker.FileCheck{
+				{Name: "SECURITY.md", Exists: true}, {Name: "LICENSE", Exists: true},
+			}},
+			want: "2 of 2 files present, 0 recommendation(s)",
+		},
+		{
+			name: "counts by priority, most severe first",
+			result: &checker.CheckResult{
+				Files: []checker.FileCheck{{Name: "SECURITY.md", Exists: true}, {Name: "LICENSE"}},
+				Recommendations: []checker.Recommendation{
+					rec("low"), rec("high"), rec("low"), rec("critical"),
+				},
+			},
+			want: "1 of 2 files present, 4 recommendation(s) (1 critical, 1 high, 2 low)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarize(tt.result); got != tt.want {
+				t.Errorf("summarize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReporter_OnlyMissing(t *testing.T) {
+	result := &checker.CheckResult{
+		Path: "example",
+		Files: []checker.FileCheck{
+			{Name: "SECURITY-INSIGHTS.yml", Path: "example/SECURITY-INSIGHTS.yml", Exists: true, Valid: true},
+			{Name: "LICENSE"},
+		},
+		MissingFiles: []string{"LICENSE"},
+		Recommendations: []checker.Recommendation{
+			{Priority: "high", Description: "LICENSE file is missing", File: "LICENSE"},
+			{Priority: "medium", Description: "SECURITY-INSIGHTS.yml differs from the manifest", File: "SECURITY-INSIGHTS.yml"},
+			{Priority: "low", Description: "Enable branch protection"},
+		},
+	}
+
+	tests := []struct {
+		format      string
+		onlyMissing bool
+		want        []string
+		notWant     []string
+	}{
+		{
+			format: "text",
+			want:   []string{"✓ SECURITY-INSIGHTS.yml", "✗ LICENSE", "LICENSE file is missing", "differs from the manifest", "Enable branch protection"},
+		},
+		{
+			format:      "text",
+			onlyMissing: true,
+			want:        []string{"✗ LICENSE", "LICENSE file is missing"},
+			notWant:     []string{"✓ SECURITY-INSIGHTS.yml", "differs from the manifest", "Enable branch protection"},
+		},
+		{
+			format:      "markdown",
+			onlyMissing: true,
+			want:        []string{"| LICENSE |", "LICENSE file is missing"},
+			notWant:     []string{"| SECURITY-INSIGHTS.yml |", "differs from the manifest", "Enable branch protection"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s only missing %v", tt.format, tt.onlyMissing), func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter(tt.format)
+			r.SetOutput(&buf)
+			r.SetOnlyMissing(tt.onlyMissing)
+			if err := r.OutputCheckResult(result); err != nil {
+				t.Fatalf("OutputCheckResult() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, buf.String())
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(buf.String(), notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestReporter_JSONCompact(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		multi     bool
+		wantLines int
+	}{
+		{name: "pretty", format: "json", wantLines: 0},
+		{name: "compact", format: "json-compact", wantLines: 1},
+		{name: "pretty multi", format: "json", multi: true, wantLines: 0},
+		{name: "compact multi", format: "json-compact", multi: true, wantLines: 1},
+	}
+
+	// render returns the report and its value decoded as generic JSON
+	render := func(t *testing.T, format string, multi bool) (string, interface{}) {
+		t.Helper()
+		var buf bytes.Buffer
+		r := NewReporter(format)
+		r.SetOutput(&buf)
+		var err error
+		if multi {
+			err = r.OutputMultiResult([]*checker.CheckResult{compliantResult()})
+		} else {
+			err = r.OutputCheckResult(compliantResult())
+		}
+		if err != nil {
+			t.Fatalf("output error = %v", err)
+		}
+		var value interface{}
+		if err := json.Unmarshal(buf.Bytes(), &value); err != nil {
+			t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+		}
+		return buf.String(), value
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, value := render(t, tt.format, tt.multi)
+			lines := strings.Count(out, "\n")
+			if tt.wantLines > 0 && lines != tt.wantLines {
+				t.Errorf("output spans %d lines, want %d:\n%s", lines, tt.wantLines, out)
+			}
+			if tt.wantLines == 0 && !strings.Contains(out, "\n  \"") {
+				t.Errorf("output is not indented by two spaces:\n%s", out)
+			}
+
+			// Both variants carry the same data
+			_, pretty := render(t, "json", tt.multi)
+			if !reflect.DeepEqual(value, pretty) {
+				t.Errorf("%s output differs from json output", tt.format)
+			}
+		})
+	}
+}
+
+func TestSortRecommendations(t *testing.T) {
+	recs := []checker.Recommendation{
+		{ID: "a", Priority: "low", Category: "Community", Effort: "auto"},
+		{ID: "b", Priority: "high", Category: "Legal", Effort: "policy"},
+		{ID: "c", Priority: "medium", Category: "Community", Effort: "manual"},
+		{ID: "d", Priority: "critical", Category: "Security Policy", Effort: "auto"},
+		{ID: "e", Priority: "high", Category: "Community"},
+	}
+
+	tests := []struct {
+		sortBy  string
+		want    string
+		wantErr bool
+	}{
+		{sortBy: "", want: "dbeca"},
+		{sortBy: "priority", want: "dbeca"},
+		// Ties keep priority order
+		{sortBy: "category", want: "ecabd"},
+		// Quick wins first; recommendations without an effort come last
+		{sortBy: "effort", want: "dacbe"},
+		{sortBy: "impact", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			sorted, err := sortRecommendations(recs, tt.sortBy)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("sortRecommendations(%q) error = nil, want unsupported sort", tt.sortBy)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("sortRecommendations(%q) error = %v", tt.sortBy, err)
+			}
+			var got strings.Builder
+			for _, rec := range sorted {
+				got.WriteString(rec.ID)
+			}
+			if got.String() != tt.want {
+				t.Errorf("sortRecommendations(%q) = %s, want %s", tt.sortBy, got.String(), tt.want)
+			}
+		})
+	}
+
+	// The input is left in its original order
+	if recs[0].ID != "a" || recs[4].ID != "e" {
+		t.Errorf("sortRecommendations() reordered its input: %+v", recs)
+	}
+}
+
+func TestReporter_SortEffort(t *testing.T) {
+	result := compliantResult()
+	result.Recommendations = []checker.Recommendation{
+		{Priority: "high", Description: "Choose a license", Effort: "policy"},
+		{Priority: "low", Description: "Add a code of conduct", Effort: "auto"},
+	}
+
+	var buf bytes.Buffer
+	r := NewReporter("text")
+	r.SetOutput(&buf)
+	r.SetSort("effort")
+	if err := r.OutputCheckResult(result); err != nil {
+		t.Fatalf("OutputCheckResult() error = %v", err)
+	}
+	out := buf.String()
+	if strings.Index(out, "Add a code of conduct") > strings.Index(out, "Choose a license") {
+		t.Errorf("--sort effort did not list the auto fix first:\n%s", out)
+	}
+
+	r.SetSort("size")
+	if err := r.OutputCheckResult(result); err == nil {
+		t.Error("OutputCheckResult() accepted an unknown sort")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+// githubEscapeData escapes the message of a GitHub Actions workflow command
+var githubEscapeData = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+
+// githubEscapeProperty escapes a property value, such as file=, of a GitHub
+// Actions workflow command
+var githubEscapeProperty = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+
+// githubCommand formats one workflow command line, such as
+// "::error file=SECURITY.md,title=SECURITY.md is missing::message"
+func githubCommand(command, file, title, message string) string {
+	var properties []string
+	if file != "" {
+		properties = append(properties, "file="+githubEscapeProperty.Replace(file))
+	}
+	if title != "" {
+		properties = append(properties, "title="+githubEscapeProperty.Replace(title))
+	}
+
+	line := "::" + command
+	if len(properties) > 0 {
+		line += " " + strings.Join(properties, ",")
+	}
+	return line + "::" + githubEscapeData.Replace(message)
+}
+
+// githubPath returns path, with forward slashes, relative to the directory
+// GitHub resolves annotation paths against: GITHUB_WORKSPACE in Actions and
+// the working directory elsewhere. Paths outside it stay absolute.
+func githubPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	base := os.Getenv("GITHUB_WORKSPACE")
+	if base == "" {
+		if base, err = os.Getwd(); err != nil {
+			return filepath.ToSlash(abs)
+		}
+	}
+	rel, err := filepath.Rel(base, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(abs)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// outputGitHub outputs missing files and file errors and warnings as GitHub
+// Actions annotations, followed by a summary notice. Missing files are
+// errors or warnings by the priority of their recommendation, as in SARIF,
+// and are annotated at the path they were expected at.
+func (r *Reporter) outputGitHub(result *checker.CheckResult) error {
+	priorities := map[string]string{}
+	for _, rec := range result.Recommendations {
+		if _, seen := priorities[rec.File]; !seen && rec.File != "" {
+			priorities[rec.File] = rec.Priority
+		}
+	}
+
+	var lines []string
+	for _, missing := range result.MissingFiles {
+		priority := priorities[missing]
+		if priority == "" {
+			priority = "high"
+		}
+		lines = append(lines, githubCommand(sarifLevel(priority), githubPath(filepath.Join(result.Path, missing)),
+			fmt.Sprintf("%s is missing", missing), fmt.Sprintf("%s file is missing", missing)))
+	}
+
+	for _, file := range result.Files {
+		if !file.Exists {
+			continue
+		}
+		path := githubPath(file.Path)
+		for _, e := range file.Errors {
+			lines = append(lines, githubCommand("error", path, fmt.Sprintf("%s is not valid", file.Name), e))
+		}
+		for _, w := range file.Warnings {
+			lines = append(lines, githubCommand("warning", path, file.Name, w))
+		}
+	}
+
+	status := "compliant"
+	if !result.IsCompliant {
+		status = "not compliant"
+	}
+	summary := fmt.Sprintf("%s: %s with the OpenSSF baseline (level %d), score %d/100, %d missing file(s), %d recommendation(s)",
+		result.Path, status, result.Level, result.Score, len(result.MissingFiles), len(result.Recommendations))
+	if result.Partial {
+		summary += " (partial: the check was cut short)"
+	}
+	lines = append(lines, githubCommand("notice", "", "baseline-init", summary))
+
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(r.out, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
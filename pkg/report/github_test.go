@@ -0,0 +1,147 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestReporter_GitHub(t *testing.T) {
+	repo := t.TempDir()
+	t.Setenv("GITHUB_WORKSPACE", repo)
+	result := &checker.CheckResult{
+		Path:         repo,
+		Level:        3,
+		Score:        40,
+		MissingFiles: []string{"SECURITY.md", "LICENSE"},
+		Files: []checker.FileCheck{
+			{
+				Name:     "SECURITY-INSIGHTS.yml",
+				Path:     filepath.Join(repo, ".github", "SECURITY-INSIGHTS.yml"),
+				Exists:   true,
+				Errors:   []string{"header.url is required"},
+				Warnings: []string{"last-reviewed: 2020-01-01, is more than 12 months old\nreview it"},
+			},
+			{Name: "SECURITY.md"},
+			{Name: "LICENSE"},
+		},
+		Recommendations: []checker.Recommendation{
+			{Priority: "medium", File: "SECURITY.md"},
+			{Priority: "high", File: "LICENSE"},
+		},
+	}
+
+	var out bytes.Buffer
+	reporter := NewReporter("github")
+	reporter.SetOutput(&out)
+	if err := reporter.OutputCheckResult(result); err != nil {
+		t.Fatalf("OutputCheckResult() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	want := []string{
+		"::warning file=SECURITY.md,title=SECURITY.md is missing::SECURITY.md file is missing",
+		"::error file=LICENSE,title=LICENSE is missing::LICENSE file is missing",
+		"::error file=.github/SECURITY-INSIGHTS.yml,title=SECURITY-INSIGHTS.yml is not valid::header.url is required",
+		"::warning file=.github/SECURITY-INSIGHTS.yml,title=SECURITY-INSIGHTS.yml::last-reviewed: 2020-01-01, is more than 12 months old%0Areview it",
+	}
+	if len(lines) != len(want)+1 {
+		t.Fatalf("got %d lines, want %d annotations and a notice:\n%s", len(lines), len(want), out.String())
+	}
+	for i, line := range want {
+		if lines[i] != line {
+			t.Errorf("line %d = %q, want %q", i, lines[i], line)
+		}
+	}
+	for _, line := range lines[:len(want)] {
+		if !strings.HasPrefix(line, "::error ") && !strings.HasPrefix(line, "::warning ") {
+			t.Errorf("annotation %q does not start with ::error or ::warning", line)
+		}
+	}
+
+	notice := lines[len(lines)-1]
+	if !strings.HasPrefix(notice, "::notice title=baseline-init::") || !strings.Contains(notice, "not compliant") || !strings.Contains(notice, "40/100") {
+		t.Errorf("summary = %q, want a notice with the status and score", notice)
+	}
+}
+
+func TestReporter_GitHubMulti(t *testing.T) {
+	workspace := t.TempDir()
+	t.Setenv("GITHUB_WORKSPACE", workspace)
+	api := filepath.Join(workspace, "services", "api")
+	results := []*checker.CheckResult{
+		{
+			Path:         api,
+			MissingFiles: []string{"LICENSE"},
+			Files: []checker.FileCheck{
+				{Name: "SECURITY.md", Path: filepath.Join(api, "SECURITY.md"), Exists: true, Warnings: []string{"no response time"}},
+				{Name: "LICENSE"},
+			},
+			Recommendations: []checker.Recommendation{{Priority: "high", File: "LICENSE"}},
+		},
+		{Path: filepath.Join(workspace, "web"), IsCompliant: true},
+	}
+
+	var out bytes.Buffer
+	reporter := NewReporter("github")
+	reporter.SetOutput(&out)
+	if err := reporter.OutputMultiResult(results); err != nil {
+		t.Fatalf("OutputMultiResult() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"::error file=services/api/LICENSE,title=LICENSE is missing::LICENSE file is missing\n",
+		"::warning file=services/api/SECURITY.md,title=SECURITY.md::no response time\n",
+		"::notice title=baseline-init::1 of 2 repositories compliant with the OpenSSF baseline\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+	if got := strings.Count(out.String(), "::notice "); got != 3 {
+		t.Errorf("got %d notices, want one per repository and a total", got)
+	}
+}
+
+func TestGitHubPath(t *testing.T) {
+	workspace := t.TempDir()
+	tests := []struct {
+		name      string
+		workspace string
+		path      string
+		want      string
+	}{
+		{"inside the workspace", workspace, filepath.Join(workspace, "sub", "SECURITY.md"), "sub/SECURITY.md"},
+		{"outside the workspace", filepath.Join(workspace, "sub"), filepath.Join(workspace, "LICENSE"), filepath.ToSlash(filepath.Join(workspace, "LICENSE"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_WORKSPACE", tt.workspace)
+			if got := githubPath(tt.path); got != tt.want {
+				t.Errorf("githubPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	// Outside Actions paths are relative to the working directory
+	t.Setenv("GITHUB_WORKSPACE", "")
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(workspace); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+	if got := githubPath(filepath.Join(workspace, "LICENSE")); got != "LICENSE" {
+		t.Errorf("githubPath() without GITHUB_WORKSPACE = %q, want LICENSE", got)
+	}
+}
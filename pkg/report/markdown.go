@@ -0,0 +1,97 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+// markdownCell escapes text for a Markdown table cell
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", " ")
+}
+
+// markdownCheck renders a yes/no table cell
+func markdownCheck(ok bool) string {
+	if ok {
+		return "✅"
+	}
+	return "❌"
+}
+
+// outputMarkdown outputs results as Markdown for PR comments and wiki pages
+func (r *Reporter) outputMarkdown(result *checker.CheckResult) error {
+	fmt.Fprintln(r.out, "# OpenSSF Baseline Compliance Check")
+	fmt.Fprintln(r.out)
+	fmt.Fprintf(r.out, "Repository: `%s`\n\n", result.Path)
+	if result.Level > 0 {
+		fmt.Fprintf(r.out, "Baseline level: %d\n\n", result.Level)
+	}
+
+	if result.IsCompliant {
+		fmt.Fprintln(r.out, "![OpenSSF Baseline: compliant](https://img.shields.io/badge/OpenSSF_Baseline-compliant-brightgreen) **Status: ✅ COMPLIANT**")
+	} else {
+		fmt.Fprintln(r.out, "![OpenSSF Baseline: not compliant](https://img.shields.io/badge/OpenSSF_Baseline-not_compliant-red) **Status: ❌ NOT COMPLIANT**")
+	}
+	fmt.Fprintln(r.out)
+	fmt.Fprintf(r.out, "Summary: %s\n", summarize(result))
+	if result.Partial {
+		fmt.Fprintln(r.out)
+		fmt.Fprintln(r.out, "> ⚠️ Partial results due to timeout: some checks did not run")
+	}
+	fmt.Fprintln(r.out)
+
+	// File checks
+	fmt.Fprintln(r.out, "## File Checks")
+	fmt.Fprintln(r.out)
+	fmt.Fprintln(r.out, "| Name | Exists | Valid | Location |")
+	fmt.Fprintln(r.out, "| --- | --- | --- | --- |")
+	for _, file := range result.Files {
+		if file.Exists && r.onlyMissing {
+			continue
+		}
+		valid, location := "", ""
+		if file.Exists {
+			valid = markdownCheck(file.Valid && len(file.Errors) == 0)
+			if file.Path != "" {
+				location = "`" + markdownCell(file.Path) + "`"
+			}
+		}
+		fmt.Fprintf(r.out, "| %s | %s | %s | %s |\n", markdownCell(file.Name), markdownCheck(file.Exists), valid, location)
+	}
+	fmt.Fprintln(r.out)
+
+	// Recommendations, grouped by priority
+	recommendations := result.Recommendations
+	if r.onlyMissing {
+		recommendations = missingRecommendations(result)
+	}
+	if len(recommendations) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(r.out, "## Recommendations")
+	for _, priority := range priorities {
+		var group []checker.Recommendation
+		for _, rec := range recommendations {
+			if rec.Priority == priority {
+				group = append(group, rec)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(r.out, "\n### %s\n\n", strings.ToUpper(priority[:1])+priority[1:])
+		for _, rec := range group {
+			fmt.Fprintf(r.out, "- **%s**: %s\n", rec.Description, rec.Action)
+		}
+	}
+
+	return nil
+}
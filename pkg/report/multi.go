@@ -0,0 +1,292 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v3"
+)
+
+// MultiResult is the report for a scan of several repositories
+type MultiResult struct {
+	ReportVersion string       `json:"report_version" yaml:"report_version"`
+	Total         int          `json:"total" yaml:"total"`
+	Compliant     int          `json:"compliant" yaml:"compliant"`
+	Partial       bool         `json:"partial,omitempty" yaml:"partial,omitempty"`
+	Groups        []GroupEntry `json:"groups" yaml:"groups"`
+}
+
+// GroupEntry is a named section of a multi-repository report
+type GroupEntry struct {
+	Name         string                 `json:"name" yaml:"name"`
+	Repositories []*checker.CheckResult `json:"repositories" yaml:"repositories"`
+}
+
+// SetGroupBy sets how multi-repository reports are sectioned: status, team
+// or score-bucket. An empty value puts every repository in one section.
+func (r *Reporter) SetGroupBy(groupBy string) {
+	r.groupBy = groupBy
+}
+
+// SetTeams maps repository paths to owning teams for --group-by team
+func (r *Reporter) SetTeams(teams map[string]string) {
+	r.teams = teams
+}
+
+// LoadTeams reads a team mapping file that lists repository paths per team:
+//
+//	platform:
+//	  - services/api
+//	security:
+//	  - tools/scanner
+func LoadTeams(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read team mapping: %w", err)
+	}
+
+	var byTeam map[string][]string
+	if err := yaml.Unmarshal(data, &byTeam); err != nil {
+		return nil, fmt.Errorf("invalid team mapping: %w", err)
+	}
+
+	teams := map[string]string{}
+	for team, repos := range byTeam {
+		for _, repo := range repos {
+			teams[filepath.Clean(repo)] = team
+		}
+	}
+	return teams, nil
+}
+
+// OutputMultiResult outputs the results of scanning several repositories
+func (r *Reporter) OutputMultiResult(results []*checker.CheckResult) error {
+	multi := &MultiResult{ReportVersion: checker.ReportVersion, Total: len(results)}
+	for i, result := range results {
+		sorted, err := sortRecommendations(result.Recommendations, r.sortBy)
+		if err != nil {
+			return err
+		}
+		sortedResult := *result
+		sortedResult.Recommendations = sorted
+		results[i] = &sortedResult
+
+		if result.IsCompliant {
+			multi.Compliant++
+		}
+		if result.Partial {
+			multi.Partial = true
+		}
+	}
+
+	groups, err := r.groupResults(results)
+	if err != nil {
+		return err
+	}
+	multi.Groups = groups
+
+	if isTemplateFormat(r.format) {
+		return outputTemplate(r.out, r.format, multi)
+	}
+
+	switch r.format {
+	case "json":
+		encoder := json.NewEncoder(r.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(multi)
+	case "json-compact":
+		return json.NewEncoder(r.out).Encode(multi)
+	case "yaml":
+		encoder := yaml.NewEncoder(r.out)
+		defer encoder.Close()
+		return encoder.Encode(multi)
+	case "text":
+		return r.outputMultiText(multi)
+	case "github":
+		return r.outputMultiGitHub(multi)
+	default:
+		return fmt.Errorf("unsupported format: %s", r.format)
+	}
+}
+
+// outputMultiGitHub outputs the annotations and notice of each repository,
+// then a notice counting the compliant ones
+func (r *Reporter) outputMultiGitHub(multi *MultiResult) error {
+	for _, group := range multi.Groups {
+		for _, result := range group.Repositories {
+			if err := r.outputGitHub(result); err != nil {
+				return err
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("%d of %d repositories compliant with the OpenSSF baseline", multi.Compliant, multi.Total)
+	if multi.Partial {
+		summary += " (partial: the check was cut short)"
+	}
+	_, err := fmt.Fprintln(r.out, githubCommand("notice", "", "baseline-init", summary))
+	return err
+}
+
+// groupResults sections results according to the reporter's groupBy setting
+func (r *Reporter) groupResults(results []*checker.CheckResult) ([]GroupEntry, error) {
+	var keyFor func(result *checker.CheckResult) string
+	var order []string
+
+	switch r.groupBy {
+	case "":
+		return []GroupEntry{{Name: "all", Repositories: results}}, nil
+	case "status":
+		keyFor = func(result *checker.CheckResult) string {
+			if result.IsCompliant {
+				return "compliant"
+			}
+			return "not compliant"
+		}
+		order = []string{"not compliant", "compliant"}
+	case "score-bucket":
+		keyFor = func(result *checker.CheckResult) string {
+			return scoreBucket(result.Score)
+		}
+		order = scoreBuckets
+	case "team":
+		keyFor = func(result *checker.CheckResult) string {
+			return r.teamFor(result.Path)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported group-by: %s", r.groupBy)
+	}
+
+	byKey := map[string][]*checker.CheckResult{}
+	for _, result := range results {
+		key := keyFor(result)
+		byKey[key] = append(byKey[key], result)
+	}
+
+	if order == nil {
+		for key := range byKey {
+			if key != "unassigned" {
+				order = append(order, key)
+			}
+		}
+		sort.Strings(order)
+		order = append(order, "unassigned")
+	}
+
+	groups := []GroupEntry{}
+	for _, key := range order {
+		if len(byKey[key]) > 0 {
+			groups = append(groups, GroupEntry{Name: key, Repositories: byKey[key]})
+		}
+	}
+	return groups, nil
+}
+
+// teamFor returns the team owning repoPath, matching the longest mapped path
+// that equals or contains it. Paths are compared in absolute, cleaned form,
+// so ./repos/api, repos/api/ and the path --recursive finds all match.
+func (r *Reporter) teamFor(repoPath string) string {
+	repoPath = absPath(repoPath)
+
+	team, longest := "unassigned", -1
+	for path, t := range r.teams {
+		path = absPath(path)
+		if (repoPath == path || strings.HasPrefix(repoPath, path+string(filepath.Separator))) && len(path) > longest {
+			team, longest = t, len(path)
+		}
+	}
+	return team
+}
+
+// absPath returns path made absolute against the working directory, or
+// just cleaned when that fails
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// scoreBuckets lists the ranges used by scoreBucket, lowest first
+var scoreBuckets = []string{"0-49%", "50-79%", "80-99%", "100%"}
+
+// scoreBucket places a compliance score into a coarse range
+func scoreBucket(score int) string {
+	switch {
+	case score >= 100:
+		return "100%"
+	case score >= 80:
+		return "80-99%"
+	case score >= 50:
+		return "50-79%"
+	default:
+		return "0-49%"
+	}
+}
+
+// outputMultiText outputs a multi-repository report as human-readable text
+func (r *Reporter) outputMultiText(multi *MultiResult) error {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	bold := color.New(color.Bold).SprintFunc()
+
+	fmt.Fprintln(r.out, bold("OpenSSF Baseline Compliance Check"))
+	fmt.Fprintln(r.out, strings.Repeat("=", 50))
+	fmt.Fprintf(r.out, "Repositories: %d (%d compliant, %d not compliant)\n",
+		multi.Total, multi.Compliant, multi.Total-multi.Compliant)
+	if multi.Partial {
+		yellow := color.New(color.FgYellow).SprintFunc()
+		fmt.Fprintln(r.out, yellow("⚠ Partial results due to timeout: some repositories were not fully checked"))
+	}
+	if r.showLegend {
+		fmt.Fprintf(r.out, "Legend: %s compliant  %s not compliant\n", green("✓"), red("✗"))
+	}
+
+	for _, group := range multi.Groups {
+		repositories := group.Repositories
+		if r.onlyMissing {
+			// Leave out repositories without missing files
+			repositories = nil
+			for _, result := range group.Repositories {
+				if len(result.MissingFiles) > 0 {
+					repositories = append(repositories, result)
+				}
+			}
+			if len(repositories) == 0 {
+				continue
+			}
+		}
+
+		if r.groupBy != "" {
+			fmt.Fprintf(r.out, "\n%s\n", bold(fmt.Sprintf("%s (%d)", group.Name, len(repositories))))
+		} else {
+			fmt.Fprintln(r.out)
+		}
+
+		for _, result := range repositories {
+			if result.IsCompliant {
+				fmt.Fprintf(r.out, "  %s %s (%d%%)\n", green("✓"), result.Path, result.Score)
+			} else {
+				fmt.Fprintf(r.out, "  %s %s (%d%%)\n", red("✗"), result.Path, result.Score)
+			}
+			if result.Partial {
+				fmt.Fprintln(r.out, "    Partial: check stopped before completion")
+			}
+			if len(result.MissingFiles) > 0 {
+				fmt.Fprintf(r.out, "    Missing: %s\n", strings.Join(result.MissingFiles, ", "))
+			}
+		}
+	}
+	fmt.Fprintln(r.out)
+
+	return nil
+}
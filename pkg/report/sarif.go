@@ -0,0 +1,161 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+// SARIF 2.1.0 documents, reduced to the properties code scanning reads
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolURI = "https://github.com/aguamala/baseline-init"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string            `json:"id"`
+	ShortDescription     sarifMessage      `json:"shortDescription"`
+	DefaultConfiguration sarifRuleDefaults `json:"defaultConfiguration"`
+}
+
+type sarifRuleDefaults struct {
+	Level string `json:"level"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+// sarifLevel maps a recommendation priority to a SARIF result level
+func sarifLevel(priority string) string {
+	switch priority {
+	case "critical", "high":
+		return "error"
+	default:
+		return "warning"
+	}
+}
+
+// sarifRuleID builds a rule ID such as missing-security-insights from a
+// finding kind and the file it concerns
+func sarifRuleID(kind, file string) string {
+	name := checker.RuleName(file)
+	if name == "" {
+		name = strings.ToLower(strings.TrimSuffix(file, filepath.Ext(file)))
+	}
+	return kind + "-" + name
+}
+
+// outputSARIF outputs missing files and validation errors as a SARIF log for
+// code scanning. Locations are relative to the repository root.
+func (r *Reporter) outputSARIF(result *checker.CheckResult) error {
+	priorities := map[string]string{}
+	for _, rec := range result.Recommendations {
+		if _, seen := priorities[rec.File]; !seen && rec.File != "" {
+			priorities[rec.File] = rec.Priority
+		}
+	}
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "baseline-init",
+			InformationURI: sarifToolURI,
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	rules := map[string]bool{}
+	add := func(ruleID, description, level, message, uri string) {
+		if !rules[ruleID] {
+			rules[ruleID] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:                   ruleID,
+				ShortDescription:     sarifMessage{Text: description},
+				DefaultConfiguration: sarifRuleDefaults{Level: level},
+			})
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  ruleID,
+			Level:   level,
+			Message: sarifMessage{Text: message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: uri, URIBaseID: "%SRCROOT%"},
+			}}},
+		})
+	}
+
+	for _, missing := range result.MissingFiles {
+		priority := priorities[missing]
+		if priority == "" {
+			priority = "high"
+		}
+		add(sarifRuleID("missing", missing), fmt.Sprintf("%s is missing", missing),
+			sarifLevel(priority), fmt.Sprintf("%s file is missing", missing), missing)
+	}
+
+	for _, file := range result.Files {
+		if !file.Exists {
+			continue
+		}
+		uri := file.Name
+		if rel, err := filepath.Rel(result.Path, file.Path); err == nil {
+			uri = filepath.ToSlash(rel)
+		}
+		for _, e := range file.Errors {
+			add(sarifRuleID("invalid", file.Name), fmt.Sprintf("%s is not valid", file.Name),
+				"error", e, uri)
+		}
+	}
+
+	encoder := json.NewEncoder(r.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}})
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestReporter_SARIF(t *testing.T) {
+	repo := t.TempDir()
+	result := &checker.CheckResult{
+		Path:         repo,
+		MissingFiles: []string{"SECURITY.md", "LICENSE"},
+		Files: []checker.FileCheck{
+			{
+				Name:   "SECURITY-INSIGHTS.yml",
+				Path:   filepath.Join(repo, "SECURITY-INSIGHTS.yml"),
+				Exists: true,
+				Errors: []string{"header.url is required", "project.name is required"},
+			},
+			{Name: "SECURITY.md", Path: filepath.Join(repo, "SECURITY.md")},
+			{Name: "LICENSE", Path: filepath.Join(repo, "LICENSE")},
+		},
+		Recommendations: []checker.Recommendation{
+			{Priority: "medium", File: "SECURITY.md"},
+			{Priority: "high", File: "LICENSE"},
+		},
+	}
+
+	var out bytes.Buffer
+	reporter := NewReporter("sarif")
+	reporter.SetOutput(&out)
+	if err := reporter.OutputCheckResult(result); err != nil {
+		t.Fatalf("OutputCheckResult() error = %v", err)
+	}
+	data := out.Bytes()
+
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, data)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("version = %q, runs = %d, want 2.1.0 with one run", log.Version, len(log.Runs))
+	}
+
+	results := log.Runs[0].Results
+	if want := len(result.MissingFiles) + len(result.Files[0].Errors); len(results) != want {
+		t.Fatalf("len(runs[0].results) = %d, want %d", len(results), want)
+	}
+
+	want := []struct {
+		ruleID string
+		level  string
+		uri    string
+	}{
+		{"missing-security-policy", "warning", "SECURITY.md"},
+		{"missing-license", "error", "LICENSE"},
+		{"invalid-security-insights", "error", "SECURITY-INSIGHTS.yml"},
+		{"invalid-security-insights", "error", "SECURITY-INSIGHTS.yml"},
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.RuleID != w.ruleID || got.Level != w.level {
+			t.Errorf("results[%d] = %s/%s, want %s/%s", i, got.RuleID, got.Level, w.ruleID, w.level)
+		}
+		if uri := got.Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != w.uri {
+			t.Errorf("results[%d] uri = %q, want %q", i, uri, w.uri)
+		}
+	}
+	if rules := log.Runs[0].Tool.Driver.Rules; len(rules) != 3 {
+		t.Errorf("len(rules) = %d, want 3", len(rules))
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/fatih/color"
+)
+
+// Stats summarizes a collection of saved check results
+type Stats struct {
+	Reports           int           `json:"reports"`
+	Compliant         int           `json:"compliant"`
+	PercentCompliant  int           `json:"percent_compliant"`
+	AverageScore      int           `json:"average_score"`
+	MissingFiles      []FileCount   `json:"missing_files"`      // most common first
+	ScoreDistribution []BucketCount `json:"score_distribution"` // lowest range first
+}
+
+// FileCount is how many reports lack a required file
+type FileCount struct {
+	Name    string `json:"name"`
+	Reports int    `json:"reports"`
+}
+
+// BucketCount is how many reports fall into a score range
+type BucketCount struct {
+	Range   string `json:"range"`
+	Reports int    `json:"reports"`
+}
+
+// Summarize aggregates results into portfolio statistics
+func Summarize(results []*checker.CheckResult) *Stats {
+	stats := &Stats{Reports: len(results), MissingFiles: []FileCount{}}
+
+	missing := map[string]int{}
+	buckets := map[string]int{}
+	totalScore := 0
+	for _, result := range results {
+		if result.IsCompliant {
+			stats.Compliant++
+		}
+		if result.MaxScore == 0 {
+			// Reports written before scores were recorded
+			result.UpdateScore()
+		}
+		score := result.Score
+		totalScore += score
+		buckets[scoreBucket(score)]++
+		for _, name := range result.MissingFiles {
+			missing[name]++
+		}
+	}
+
+	if stats.Reports > 0 {
+		stats.PercentCompliant = stats.Compliant * 100 / stats.Reports
+		stats.AverageScore = totalScore / stats.Reports
+	}
+
+	for name, count := range missing {
+		stats.MissingFiles = append(stats.MissingFiles, FileCount{Name: name, Reports: count})
+	}
+	sort.Slice(stats.MissingFiles, func(i, j int) bool {
+		a, b := stats.MissingFiles[i], stats.MissingFiles[j]
+		if a.Reports != b.Reports {
+			return a.Reports > b.Reports
+		}
+		return a.Name < b.Name
+	})
+
+	for _, bucket := range scoreBuckets {
+		stats.ScoreDistribution = append(stats.ScoreDistribution, BucketCount{Range: bucket, Reports: buckets[bucket]})
+	}
+	return stats
+}
+
+// OutputStats outputs portfolio statistics as text or JSON
+func (r *Reporter) OutputStats(stats *Stats) error {
+	switch r.format {
+	case "json":
+		encoder := json.NewEncoder(r.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	case "text":
+		return r.outputStatsText(stats)
+	default:
+		return fmt.Errorf("unsupported format: %s", r.format)
+	}
+}
+
+// statsBarWidth is the length of a bar covering every report
+const statsBarWidth = 30
+
+// outputStatsText prints statistics with a bar chart of the score
+// distribution
+func (r *Reporter) outputStatsText(stats *Stats) error {
+	bold := color.New(color.Bold).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+
+	fmt.Fprintln(r.out, bold("OpenSSF Baseline Compliance Statistics"))
+	fmt.Fprintln(r.out, strings.Repeat("=", 50))
+	fmt.Fprintf(r.out, "Reports: %d\n", stats.Reports)
+	fmt.Fprintf(r.out, "Compliant: %d (%d%%)\n", stats.Compliant, stats.PercentCompliant)
+	fmt.Fprintf(r.out, "Average score: %d%%\n\n", stats.AverageScore)
+
+	if len(stats.MissingFiles) == 0 {
+		fmt.Fprintf(r.out, "%s No report is missing a required file\n\n", green("✓"))
+	} else {
+		top := stats.MissingFiles[0]
+		fmt.Fprintf(r.out, "Most common missing file: %s (%d of %d reports)\n\n", top.Name, top.Reports, stats.Reports)
+		fmt.Fprintln(r.out, bold("Missing Files:"))
+		for _, file := range stats.MissingFiles {
+			fmt.Fprintf(r.out, "  %-24s %d\n", file.Name, file.Reports)
+		}
+		fmt.Fprintln(r.out)
+	}
+
+	fmt.Fprintln(r.out, bold("Score Distribution:"))
+	for _, bucket := range stats.ScoreDistribution {
+		bar := ""
+		if stats.Reports > 0 {
+			bar = strings.Repeat("█", bucket.Reports*statsBarWidth/stats.Reports)
+		}
+		fmt.Fprintf(r.out, "  %-7s %4d  %s\n", bucket.Range, bucket.Reports, bar)
+	}
+	return nil
+}
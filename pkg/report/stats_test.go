@@ -0,0 +1,113 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestSummarize(t *testing.T) {
+	// legacyResult is a report written before scores were recorded
+	legacyResult := func(path string, missing ...string) *checker.CheckResult {
+		result := &checker.CheckResult{
+			Path:  path,
+			Files: []checker.FileCheck{{Name: "SECURITY-INSIGHTS.yml", Exists: true, Valid: true}},
+		}
+		for _, name := range missing {
+			result.Files = append(result.Files, checker.FileCheck{Name: name})
+			result.MissingFiles = append(result.MissingFiles, name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		results []*checker.CheckResult
+		want    Stats
+	}{
+		{
+			name:    "empty input",
+			results: nil,
+			want: Stats{
+				MissingFiles:      []FileCount{},
+				ScoreDistribution: []BucketCount{{"0-49%", 0}, {"50-79%", 0}, {"80-99%", 0}, {"100%", 0}},
+			},
+		},
+		{
+			name:    "mixed report versions",
+			results: []*checker.CheckResult{compliantResult(), legacyResult("legacy", "LICENSE")},
+			want: Stats{
+				Reports:           2,
+				Compliant:         1,
+				PercentCompliant:  50,
+				AverageScore:      75,
+				MissingFiles:      []FileCount{{"LICENSE", 1}},
+				ScoreDistribution: []BucketCount{{"0-49%", 0}, {"50-79%", 1}, {"80-99%", 0}, {"100%", 1}},
+			},
+		},
+		{
+			name: "missing files by count then name",
+			results: []*checker.CheckResult{
+				legacyResult("a", "SECURITY.md", "LICENSE"),
+				legacyResult("b", "SECURITY.md"),
+				legacyResult("c", "CODEOWNERS"),
+			},
+			want: Stats{
+				Reports:           3,
+				AverageScore:      52,
+				MissingFiles:      []FileCount{{"SECURITY.md", 2}, {"CODEOWNERS", 1}, {"LICENSE", 1}},
+				ScoreDistribution: []BucketCount{{"0-49%", 1}, {"50-79%", 2}, {"80-99%", 0}, {"100%", 0}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Summarize(tt.results)
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Summarize() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReporter_OutputStats(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats *Stats
+		want  []string
+	}{
+		{
+			name:  "empty input",
+			stats: Summarize(nil),
+			want:  []string{"Reports: 0", "Compliant: 0 (0%)", "No report is missing a required file", "100%       0"},
+		},
+		{
+			name:  "missing files",
+			stats: &Stats{Reports: 2, MissingFiles: []FileCount{{"LICENSE", 2}}},
+			want:  []string{"Most common missing file: LICENSE (2 of 2 reports)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter("text")
+			r.SetOutput(&buf)
+			if err := r.OutputStats(tt.stats); err != nil {
+				t.Fatalf("OutputStats() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+}
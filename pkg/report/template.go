@@ -0,0 +1,61 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// templatePrefix introduces an inline Go template in the format string,
+// e.g. --format 'template={{.IsCompliant}}:{{.Score}}'
+const templatePrefix = "template="
+
+// TemplateFuncs are the helper functions available to output templates
+var TemplateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// isTemplateFormat reports whether format carries an inline template
+func isTemplateFormat(format string) bool {
+	return strings.HasPrefix(format, templatePrefix)
+}
+
+// outputTemplate executes the inline template in format against data and
+// writes it to w, ending with a newline so it behaves in shell pipelines
+func outputTemplate(w io.Writer, format string, data interface{}) error {
+	tmpl, err := parseTemplate(format)
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return fmt.Errorf("failed to execute output template: %w", err)
+	}
+	if !strings.HasSuffix(out.String(), "\n") {
+		out.WriteString("\n")
+	}
+
+	_, err = io.WriteString(w, out.String())
+	return err
+}
+
+// parseTemplate parses the inline template in format
+func parseTemplate(format string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(TemplateFuncs).Parse(strings.TrimPrefix(format, templatePrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid output template: %w", err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+)
+
+func TestReporter_Template(t *testing.T) {
+	failing := compliantResult()
+	failing.Path = "other"
+	failing.IsCompliant = false
+	failing.MissingFiles = []string{"LICENSE", "SECURITY.md"}
+
+	tests := []struct {
+		name    string
+		format  string
+		multi   bool
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "fields",
+			format: "template={{.IsCompliant}}:{{.Score}}",
+			want:   "true:100\n",
+		},
+		{
+			name:   "trailing newline kept",
+			format: "template={{.Path}}\n",
+			want:   "example\n",
+		},
+		{
+			name:   "helper functions",
+			format: `template={{upper .Path}} {{join .MissingFiles ","}} {{json .IsCompliant}}`,
+			want:   "EXAMPLE  true\n",
+		},
+		{
+			name:   "several repositories",
+			format: `template={{.Compliant}}/{{.Total}}{{range .Groups}}{{range .Repositories}} {{.Path}}{{end}}{{end}}`,
+			multi:  true,
+			want:   "1/2 example other\n",
+		},
+		{
+			name:    "parse error",
+			format:  "template={{.Score",
+			wantErr: "invalid output template",
+		},
+		{
+			name:    "unknown field",
+			format:  "template={{.Compliance}}",
+			wantErr: "failed to execute output template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := NewReporter(tt.format)
+			r.SetOutput(&buf)
+			var err error
+			if tt.multi {
+				err = r.OutputMultiResult([]*checker.CheckResult{compliantResult(), failing})
+			} else {
+				err = r.OutputCheckResult(compliantResult())
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("output error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("output error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package server exposes the checker and validator over HTTP for dashboards
+// and other tools that would otherwise shell out to the binary
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/validator"
+)
+
+// maxUploadSize caps posted documents. It is above the validator's own
+// document limit so oversized files get the validator's error rather than a
+// truncated parse.
+const maxUploadSize = 2 << 20
+
+// Server answers compliance queries over HTTP
+type Server struct {
+	root string
+	mux  *http.ServeMux
+}
+
+// New creates a Server that checks repositories under root. Requested
+// paths are resolved relative to root and may not leave it.
+func New(root string) (*Server, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve root: %w", err)
+	}
+
+	s := &Server{root: absRoot, mux: http.NewServeMux()}
+	s.mux.HandleFunc("/healthz", s.handleHealth)
+	s.mux.HandleFunc("/check", s.handleCheck)
+	s.mux.HandleFunc("/validate", s.handleValidate)
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleHealth reports that the server is up
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleCheck runs a compliance check on the repository named by the path
+// query parameter and returns the CheckResult
+func (s *Server) handleCheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "use GET /check?path=<repository>")
+		return
+	}
+
+	repoPath, err := s.resolve(r.URL.Query().Get("path"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if info, err := os.Stat(repoPath); err != nil || !info.IsDir() {
+		writeError(w, http.StatusNotFound, "repository not found: "+r.URL.Query().Get("path"))
+		return
+	}
+
+	result, err := checker.New(repoPath).CheckContext(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleValidate validates a SECURITY-INSIGHTS.yml posted as the request
+// body and returns the ValidationResult
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST a SECURITY-INSIGHTS.yml to /validate")
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxUploadSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body: "+err.Error())
+		return
+	}
+	if len(data) > maxUploadSize {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("document exceeds %d bytes", maxUploadSize))
+		return
+	}
+
+	result, err := validator.New().ValidateSecurityInsights(data)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// resolve maps a requested repository path onto the server root, refusing
+// paths that would escape it
+func (s *Server) resolve(requested string) (string, error) {
+	if requested == "" {
+		return "", fmt.Errorf("missing path parameter")
+	}
+	if filepath.IsAbs(requested) {
+		return "", fmt.Errorf("path must be relative to the server root")
+	}
+
+	resolved := filepath.Join(s.root, filepath.Clean(requested))
+	if resolved != s.root && !strings.HasPrefix(resolved, s.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("path is outside the server root")
+	}
+	return resolved, nil
+}
+
+// writeJSON encodes v as the response body
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError sends an error as {"error": message}
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
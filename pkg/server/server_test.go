@@ -0,0 +1,143 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguamala/baseline-init/pkg/checker"
+	"github.com/aguamala/baseline-init/pkg/validator"
+)
+
+func newTestServer(t *testing.T) (*Server, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	if err := os.Mkdir(repo, 0755); err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "LICENSE"), []byte("MIT License\n"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	s, err := New(root)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return s, root
+}
+
+func TestServer_Healthz(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"ok"`) {
+		t.Errorf("GET /healthz = %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestServer_Check(t *testing.T) {
+	s, root := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+	}{
+		{"repository", http.MethodGet, "/check?path=repo", http.StatusOK},
+		{"missing path", http.MethodGet, "/check", http.StatusBadRequest},
+		{"escaping root", http.MethodGet, "/check?path=../outside", http.StatusBadRequest},
+		{"absolute path", http.MethodGet, "/check?path=" + root, http.StatusBadRequest},
+		{"unknown repository", http.MethodGet, "/check?path=nope", http.StatusNotFound},
+		{"wrong method", http.MethodPost, "/check?path=repo", http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("%s %s = %d, want %d (%s)", tt.method, tt.target, rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var result checker.CheckResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("response is not a CheckResult: %v", err)
+			}
+			if result.IsCompliant || len(result.MissingFiles) == 0 {
+				t.Errorf("result = %+v, want missing files reported", result)
+			}
+			if got := result.Checks["license-present"].Status; got != checker.StatusPass {
+				t.Errorf("license-present = %q, want pass", got)
+			}
+		})
+	}
+}
+
+func TestServer_Validate(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantValid  bool
+	}{
+		{
+			name: "valid document",
+			body: `header:
+  schema-version: 2.0.0
+  url: https://github.com/example/repo
+repository:
+  url: https://github.com/example/repo
+  status: active
+`,
+			wantStatus: http.StatusOK,
+			wantValid:  true,
+		},
+		{
+			name:       "invalid document",
+			body:       "header:\n  schema-version: 2.0.0\n",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "oversized document",
+			body:       strings.Repeat("#", maxUploadSize+1),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("POST /validate = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var result validator.ValidationResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("response is not a ValidationResult: %v", err)
+			}
+			if result.IsValid != tt.wantValid {
+				t.Errorf("IsValid = %v, want %v (errors: %v)", result.IsValid, tt.wantValid, result.Errors)
+			}
+		})
+	}
+}
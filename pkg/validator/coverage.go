@@ -0,0 +1,79 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	sitooling "github.com/ossf/si-tooling/v2/si"
+)
+
+// Coverage reports how many of the optional but recommended v2 sections an
+// insights file fills in
+type Coverage struct {
+	Present int      `json:"present" yaml:"present"`
+	Total   int      `json:"total" yaml:"total"`
+	Missing []string `json:"missing,omitempty" yaml:"missing,omitempty"` // paths of empty sections, in spec order
+}
+
+// Percent returns the share of recommended sections present, rounded down
+func (c *Coverage) Percent() int {
+	if c.Total == 0 {
+		return 100
+	}
+	return c.Present * 100 / c.Total
+}
+
+// recommendedSections are the optional v2 sections that make an insights
+// file useful beyond the required minimum, in specification order
+var recommendedSections = []struct {
+	path    string
+	present func(si *sitooling.SecurityInsights) bool
+}{
+	{"project.homepage", func(si *sitooling.SecurityInsights) bool { return si.Project.Homepage != "" }},
+	{"project.roadmap", func(si *sitooling.SecurityInsights) bool { return si.Project.Roadmap != "" }},
+	{"project.funding", func(si *sitooling.SecurityInsights) bool { return si.Project.Funding != "" }},
+	{"project.documentation", func(si *sitooling.SecurityInsights) bool {
+		d := si.Project.Documentation
+		return d.DetailedGuide != "" || d.CodeOfConduct != "" || d.QuickstartGuide != "" ||
+			d.ReleaseProcess != "" || d.SignatureVerification != ""
+	}},
+	{"project.vulnerability-reporting.contact", func(si *sitooling.SecurityInsights) bool {
+		return si.Project.Vulnerability.Contact.Email != "" || si.Project.Vulnerability.Contact.Name != ""
+	}},
+	{"project.vulnerability-reporting.security-policy", func(si *sitooling.SecurityInsights) bool {
+		return si.Project.Vulnerability.SecurityPolicy != ""
+	}},
+	{"project.vulnerability-reporting.in-scope", func(si *sitooling.SecurityInsights) bool {
+		return len(si.Project.Vulnerability.InScope) > 0
+	}},
+	{"repository.documentation", func(si *sitooling.SecurityInsights) bool {
+		d := si.Repository.Documentation
+		return d.Contributing != "" || d.DependencyManagement != "" || d.Governance != "" ||
+			d.ReviewPolicy != "" || d.SecurityPolicy != ""
+	}},
+	{"repository.release.distribution-points", func(si *sitooling.SecurityInsights) bool {
+		return len(si.Repository.Release.DistributionPoints) > 0
+	}},
+	{"repository.security.champions", func(si *sitooling.SecurityInsights) bool {
+		return len(si.Repository.Security.Champions) > 0
+	}},
+	{"repository.security.tools", func(si *sitooling.SecurityInsights) bool {
+		return len(si.Repository.Security.Tools) > 0
+	}},
+	{"repository.security.assessments.third-party", func(si *sitooling.SecurityInsights) bool {
+		return len(si.Repository.Security.Assessments.ThirdParty) > 0
+	}},
+}
+
+// sectionCoverage measures which recommended sections insights fills in
+func sectionCoverage(insights *sitooling.SecurityInsights) *Coverage {
+	coverage := &Coverage{Total: len(recommendedSections)}
+	for _, section := range recommendedSections {
+		if section.present(insights) {
+			coverage.Present++
+		} else {
+			coverage.Missing = append(coverage.Missing, section.path)
+		}
+	}
+	return coverage
+}
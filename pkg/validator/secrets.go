@@ -0,0 +1,78 @@
+// Copyright 2025 baseline-init Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"fmt"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// secretPatterns match credentials by their fixed, well-known shapes only, so
+// that ordinary prose in free-text fields does not trigger them
+var secretPatterns = []struct {
+	kind    string
+	pattern *regexp.Regexp
+}{
+	{"private key", regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+	{"AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"Stripe secret key", regexp.MustCompile(`\b[sr]k_live_[0-9A-Za-z]{24,}\b`)},
+}
+
+// SecretFinding is a value in a YAML document that looks like a credential
+type SecretFinding struct {
+	Field string // dotted path to the value, such as header.comment
+	Kind  string // kind of credential, such as GitHub token
+}
+
+// String describes the finding without repeating the secret itself
+func (f SecretFinding) String() string {
+	return fmt.Sprintf("%s appears to contain a %s", f.Field, f.Kind)
+}
+
+// FindSecrets scans every string value in a YAML document for obvious
+// credential patterns. Documents that do not parse yield no findings.
+func FindSecrets(data []byte) []SecretFinding {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil
+	}
+
+	var findings []SecretFinding
+	scanSecrets(&doc, "", &findings)
+	return findings
+}
+
+// scanSecrets walks node, recording the field path of each matching scalar
+func scanSecrets(node *yaml.Node, path string, findings *[]SecretFinding) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			scanSecrets(child, path, findings)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if path != "" {
+				key = path + "." + key
+			}
+			scanSecrets(node.Content[i+1], key, findings)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			scanSecrets(child, fmt.Sprintf("%s[%d]", path, i), findings)
+		}
+	case yaml.ScalarNode:
+		for _, p := range secretPatterns {
+			if p.pattern.MatchString(node.Value) {
+				*findings = append(*findings, SecretFinding{Field: path, Kind: p.kind})
+			}
+		}
+	}
+}
@@ -4,23 +4,150 @@
 package validator
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aguamala/baseline-init/pkg/gitutil"
 	sitooling "github.com/ossf/si-tooling/v2/si"
 	"gopkg.in/yaml.v3"
 )
 
+// Limits applied before decoding untrusted YAML so that oversized or
+// adversarial documents fail with a clear error instead of exhausting memory
+const (
+	maxDocumentSize = 1 << 20 // bytes
+	maxNestingDepth = 64
+	maxYAMLNodes    = 100000 // after alias expansion
+)
+
+// mxLookupTimeout bounds each mail server lookup when network checks are on
+const mxLookupTimeout = 5 * time.Second
+
+// urlCheckTimeout bounds each page request when network checks are on
+const urlCheckTimeout = 10 * time.Second
+
+// clockSkewTolerance is how far into the future a date may be before it is
+// reported, to allow for timezone differences between author and checker
+const clockSkewTolerance = 24 * time.Hour
+
+// DefaultReviewMaxAgeMonths is how long ago header.last-reviewed may be
+// before the file is reported as due for review
+const DefaultReviewMaxAgeMonths = 12
+
+const (
+	// expirationMaxHorizonMonths is how far out a 1.0.0 expiration-date may be
+	// before it stops serving as a reminder to review the file
+	expirationMaxHorizonMonths = 18
+	// expirationRenewDays is how close to expiry a file is flagged for renewal
+	expirationRenewDays = 30
+)
+
+// DefaultSecurityURLKeywords are the path fragments expected in a url-type
+// security contact that points at a reporting or advisory page
+var DefaultSecurityURLKeywords = []string{"security", "advisories", "report"}
+
+// ReportVersion identifies the layout of ValidationResult in
+// machine-readable output; bump it on incompatible changes
+const ReportVersion = "1"
+
+// LatestSchemaVersion is the newest Security Insights schema; files that
+// declare an older one get a note suggesting an upgrade
+const LatestSchemaVersion = "2.0.0"
+
 // Validator validates compliance files
-type Validator struct{}
+type Validator struct {
+	securityURLKeywords []string
+	networkChecks       bool
+	stripPlusTags       bool
+	upgradeNotes        bool
+	historyChecks       bool
+	reviewMaxAgeMonths  int
+	lookupMX            func(ctx context.Context, domain string) ([]*net.MX, error)
+}
+
+// repoHistory describes the git history of the repository holding a
+// validated file. firstCommit is when the repository was created, or zero
+// when it is unknown; shallow is set when the history is truncated, so the
+// first commit cannot be known.
+type repoHistory struct {
+	firstCommit time.Time
+	shallow     bool
+}
 
 // ValidationResult contains validation results
 type ValidationResult struct {
+	ReportVersion string `json:"report_version" yaml:"report_version"`
+
 	IsValid  bool     `json:"is_valid"`
 	Errors   []string `json:"errors"`
 	Warnings []string `json:"warnings"`
+
+	// SchemaVersion is the schema the file was validated against. When the
+	// file does not declare a recognized version, SchemaInferred is true and
+	// SchemaVersion is the schema the file best matches.
+	SchemaVersion  string `json:"schema_version,omitempty" yaml:"schema_version,omitempty"`
+	SchemaInferred bool   `json:"schema_inferred,omitempty" yaml:"schema_inferred,omitempty"`
+
+	// SizeBytes is the size of a validated insights document, and Coverage
+	// how many recommended v2 sections it fills in
+	SizeBytes int       `json:"size_bytes,omitempty" yaml:"size_bytes,omitempty"`
+	Coverage  *Coverage `json:"coverage,omitempty" yaml:"coverage,omitempty"`
+
+	// Notes are advisory suggestions that are neither errors nor problems,
+	// such as upgrading to a newer schema
+	Notes []string `json:"notes,omitempty" yaml:"notes,omitempty"`
+
+	// YAMLErrors locates the parse and type errors that are also listed in
+	// Errors, for tools that want to point at the offending line
+	YAMLErrors []YAMLError `json:"yaml_errors,omitempty" yaml:"yaml_errors,omitempty"`
+}
+
+// YAMLError is a YAML parse or type error. Line and Column are 1-based and
+// zero when the position is not known; yaml.v3 reports only the line of
+// syntax errors.
+type YAMLError struct {
+	Line    int    `json:"line,omitempty" yaml:"line,omitempty"`
+	Column  int    `json:"column,omitempty" yaml:"column,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// String formats the error as "line N, column M: message", leaving out the
+// parts of the position that are not known
+func (e YAMLError) String() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+	case e.Line > 0:
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	default:
+		return e.Message
+	}
+}
+
+// PromoteWarnings turns the warnings into errors, prefixed "warning: " so
+// they stay recognizable, and marks the result invalid if there were any.
+// It reports whether anything was promoted.
+func (r *ValidationResult) PromoteWarnings() bool {
+	if len(r.Warnings) == 0 {
+		return false
+	}
+	for _, w := range r.Warnings {
+		r.Errors = append(r.Errors, "warning: "+w)
+	}
+	r.Warnings = nil
+	r.IsValid = false
+	return true
 }
 
 // SecurityInsights represents the SECURITY-INSIGHTS.yml structure (v1.0.0)
@@ -77,7 +204,53 @@ type SecurityInsightsV2 struct {
 
 // New creates a new Validator instance
 func New() *Validator {
-	return &Validator{}
+	return &Validator{
+		securityURLKeywords: DefaultSecurityURLKeywords,
+		upgradeNotes:        true,
+		historyChecks:       true,
+		reviewMaxAgeMonths:  DefaultReviewMaxAgeMonths,
+		lookupMX:            net.DefaultResolver.LookupMX,
+	}
+}
+
+// SetNetworkChecks enables checks that need network access, such as looking
+// up mail servers for contact email domains or requesting an advertised bug
+// bounty page. They are off by default.
+func (v *Validator) SetNetworkChecks(enabled bool) {
+	v.networkChecks = enabled
+}
+
+// SetHistoryChecks enables rules that compare the file with the repository's
+// git history, such as last-reviewed predating the first commit. They are on
+// by default and skip shallow clones, whose history is incomplete.
+func (v *Validator) SetHistoryChecks(enabled bool) {
+	v.historyChecks = enabled
+}
+
+// SetStripPlusTags makes duplicate email detection ignore "+tag"
+// sub-addresses, for contacts on providers that deliver them to the base
+// mailbox
+func (v *Validator) SetStripPlusTags(enabled bool) {
+	v.stripPlusTags = enabled
+}
+
+// SetUpgradeNotes controls the note suggesting an upgrade when a file
+// validly declares an older schema than LatestSchemaVersion. It is on by
+// default.
+func (v *Validator) SetUpgradeNotes(enabled bool) {
+	v.upgradeNotes = enabled
+}
+
+// SetReviewMaxAge sets how many months ago header.last-reviewed may be
+// before a warning that the file is due for review. Zero disables the check.
+func (v *Validator) SetReviewMaxAge(months int) {
+	v.reviewMaxAgeMonths = months
+}
+
+// SetSecurityURLKeywords replaces the path fragments used to recognize
+// security reporting links in url-type security contacts
+func (v *Validator) SetSecurityURLKeywords(keywords []string) {
+	v.securityURLKeywords = keywords
 }
 
 // ValidateFile validates a compliance file
@@ -88,21 +261,174 @@ func (v *Validator) ValidateFile(path string) (*ValidationResult, error) {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Determine file type based on name
-	filename := strings.ToLower(path)
-	if strings.Contains(filename, "security-insights") {
-		return v.validateSecurityInsights(data)
+	// Dates can be checked against the repository's history when the file
+	// lives in a git repository; elsewhere, and in shallow clones whose
+	// oldest commit is not the real first one, the check is skipped
+	var history repoHistory
+	if v.historyChecks {
+		firstCommit, err := gitutil.FirstCommitTime(filepath.Dir(path))
+		history.shallow = errors.Is(err, gitutil.ErrShallow)
+		if err == nil {
+			history.firstCommit = firstCommit
+		}
+	}
+
+	// Determine file type from the file's own name; directories such as
+	// governance-tools/ must not change how the file is validated
+	base := strings.ToLower(filepath.Base(path))
+	if strings.Contains(base, "security-insights") {
+		return v.validateSecurityInsights(data, history)
+	}
+	if strings.HasPrefix(base, "governance") && strings.HasSuffix(base, ".md") {
+		return validateGovernance(data), nil
+	}
+	if strings.Contains(base, "security") && strings.HasSuffix(base, ".md") {
+		return v.validateSecurityPolicy(data), nil
 	}
 
 	return nil, fmt.Errorf("unknown file type: %s", path)
 }
 
-// validateSecurityInsights validates SECURITY-INSIGHTS.yml
-func (v *Validator) validateSecurityInsights(data []byte) (*ValidationResult, error) {
+// governanceSections are the topics a GOVERNANCE.md is expected to cover,
+// each recognized by any of its heading keywords
+var governanceSections = []struct {
+	name     string
+	keywords []string
+}{
+	{"the decision-making process", []string{"decision"}},
+	{"roles and responsibilities", []string{"role", "maintainers", "responsibilit"}},
+	{"how to become a maintainer", []string{"becom", "nominat", "join"}},
+}
+
+// validateGovernance checks that GOVERNANCE.md has headings covering the key
+// governance topics. Missing topics are warnings; an empty file is an error.
+func validateGovernance(data []byte) *ValidationResult {
+	result := &ValidationResult{
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+	}
+
+	var headings []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "#") {
+			headings = append(headings, strings.ToLower(strings.TrimLeft(trimmed, "# ")))
+		}
+	}
+
+	if strings.TrimSpace(string(data)) == "" {
+		result.IsValid = false
+		result.Errors = append(result.Errors, "GOVERNANCE.md is empty")
+		return result
+	}
+
+	for _, section := range governanceSections {
+		found := false
+		for _, heading := range headings {
+			for _, keyword := range section.keywords {
+				if strings.Contains(heading, keyword) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("No section describing %s", section.name))
+		}
+	}
+
+	return result
+}
+
+// emailPattern matches email addresses in prose and mailto: links
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// ExtractContactEmails returns the distinct email addresses in a security
+// policy, in order of appearance, which is where reports are routed
+func ExtractContactEmails(data []byte) []string {
+	var emails []string
+	seen := make(map[string]bool)
+	for _, email := range emailPattern.FindAllString(string(data), -1) {
+		key := NormalizeEmail(email, false)
+		if !seen[key] {
+			seen[key] = true
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// reportingURLPattern matches web links a reporter could follow, such as an
+// advisory form or bug bounty page
+var reportingURLPattern = regexp.MustCompile(`https?://[^\s)>\]]+`)
+
+// responseTimePattern matches a commitment such as "within 48 hours" or
+// "in two business days"
+var responseTimePattern = regexp.MustCompile(`(?i)\b(\d+|one|two|three|four|five|seven|ten|fourteen)\s+(business\s+|working\s+)?(hours?|days?|weeks?)\b`)
+
+// validateSecurityPolicy checks that SECURITY.md tells reporters where to
+// send a vulnerability and when to expect an answer. Gaps are warnings. With
+// network checks enabled, each reporting address must also have a domain
+// that accepts mail.
+func (v *Validator) validateSecurityPolicy(data []byte) *ValidationResult {
+	result := &ValidationResult{
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+	}
+
+	emails := ExtractContactEmails(data)
+	if len(emails) == 0 && !reportingURLPattern.Match(data) {
+		result.Warnings = append(result.Warnings,
+			"SECURITY.md gives no email address or URL for reporting vulnerabilities")
+	}
+
+	hasReportingSection := false
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") && strings.Contains(strings.ToLower(trimmed), "report") {
+			hasReportingSection = true
+		}
+	}
+	if !hasReportingSection {
+		result.Warnings = append(result.Warnings,
+			`SECURITY.md has no "Reporting a Vulnerability" section`)
+	}
+
+	if !responseTimePattern.Match(data) {
+		result.Warnings = append(result.Warnings,
+			`SECURITY.md does not commit to a response time, such as "within 48 hours"`)
+	}
+
+	for _, email := range emails {
+		v.checkEmailDomain(result, "SECURITY.md contact "+email, email)
+	}
+	return result
+}
+
+// ValidateSecurityInsights validates SECURITY-INSIGHTS.yml content that is
+// not read from disk, such as a rewritten copy. With no repository to look
+// at, dates are not compared with its history.
+func (v *Validator) ValidateSecurityInsights(data []byte) (*ValidationResult, error) {
+	return v.validateSecurityInsights(data, repoHistory{})
+}
+
+// validateSecurityInsights validates SECURITY-INSIGHTS.yml, comparing dates
+// with the history of the repository holding it
+func (v *Validator) validateSecurityInsights(data []byte, history repoHistory) (*ValidationResult, error) {
 	result := &ValidationResult{
-		IsValid:  true,
-		Errors:   []string{},
-		Warnings: []string{},
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+	}
+
+	if err := checkDocumentLimits(data); err != nil {
+		result.IsValid = false
+		result.Errors = append(result.Errors, err.Error())
+		return result, nil
 	}
 
 	// First, detect schema version
@@ -112,34 +438,102 @@ func (v *Validator) validateSecurityInsights(data []byte) (*ValidationResult, er
 		} `yaml:"header"`
 	}
 	if err := yaml.Unmarshal(data, &header); err != nil {
-		result.IsValid = false
-		result.Errors = append(result.Errors, fmt.Sprintf("Invalid YAML: %v", err))
+		addYAMLError(result, data, "Invalid YAML", err)
 		return result, nil
 	}
 
 	// Determine version and validate accordingly
-	schemaVersion := fmt.Sprintf("%v", header.Header.SchemaVersion)
+	schemaVersion := ""
+	if header.Header.SchemaVersion != nil {
+		schemaVersion = fmt.Sprintf("%v", header.Header.SchemaVersion)
+	}
+
+	var err error
+	switch {
+	case strings.HasPrefix(schemaVersion, "2."):
+		result, err = v.validateSecurityInsightsV2(data, history)
+	case strings.HasPrefix(schemaVersion, "1."):
+		result, err = v.validateSecurityInsightsV1(data, history)
+	default:
+		result, err = v.validateSecurityInsightsBestFit(data, schemaVersion, history)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	if strings.HasPrefix(schemaVersion, "2.") {
-		return v.validateSecurityInsightsV2(data)
+	result.SizeBytes = len(data)
+
+	if v.upgradeNotes && result.IsValid && !result.SchemaInferred && result.SchemaVersion != LatestSchemaVersion {
+		result.Notes = append(result.Notes,
+			fmt.Sprintf("Schema %s is not the latest; consider upgrading to %s, which adds sections such as repository.security and project.vulnerability-reporting; 'baseline-init migrate' converts 1.x files (see https://github.com/ossf/security-insights-spec)",
+				result.SchemaVersion, LatestSchemaVersion))
 	}
 
-	// Default to v1 validation
-	return v.validateSecurityInsightsV1(data)
+	// The file is committed publicly, so flag credentials pasted into it
+	for _, finding := range FindSecrets(data) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("Possible secret: %s; remove it and rotate the credential", finding))
+	}
+	return result, nil
+}
+
+// validateSecurityInsightsBestFit validates a file without a recognized
+// schema-version against both schemas and keeps the result with the fewest
+// errors. Ties go to v1, which was the historical default.
+func (v *Validator) validateSecurityInsightsBestFit(data []byte, declared string, history repoHistory) (*ValidationResult, error) {
+	v1Result, err := v.validateSecurityInsightsV1(data, history)
+	if err != nil {
+		return nil, err
+	}
+
+	v2Result := &ValidationResult{
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+		SchemaVersion: "2.0.0",
+	}
+	var insights sitooling.SecurityInsights
+	if err := yaml.Unmarshal(data, &insights); err != nil {
+		addYAMLError(v2Result, data, "Schema validation failed", err)
+	} else {
+		if declared == "" {
+			v2Result.IsValid = false
+			v2Result.Errors = append(v2Result.Errors, "Missing required field: header.schema-version")
+		}
+		v.checkSecurityInsightsV2(&insights, v2Result, history)
+	}
+
+	result, other := v1Result, v2Result
+	if len(v2Result.Errors) < len(v1Result.Errors) {
+		result, other = v2Result, v1Result
+	}
+	result.SchemaInferred = true
+
+	reason := "No schema-version declared"
+	if declared != "" {
+		reason = fmt.Sprintf("Unrecognized schema-version %q", declared)
+	}
+	result.Warnings = append(result.Warnings,
+		fmt.Sprintf("%s; file best matches schema %s (%d errors, vs %d for schema %s)",
+			reason, result.SchemaVersion, len(result.Errors), len(other.Errors), other.SchemaVersion))
+
+	return result, nil
 }
 
 // validateSecurityInsightsV1 validates SECURITY-INSIGHTS.yml schema v1.0.0
-func (v *Validator) validateSecurityInsightsV1(data []byte) (*ValidationResult, error) {
+func (v *Validator) validateSecurityInsightsV1(data []byte, history repoHistory) (*ValidationResult, error) {
 	result := &ValidationResult{
-		IsValid:  true,
-		Errors:   []string{},
-		Warnings: []string{},
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+		SchemaVersion: "1.0.0",
 	}
 
 	var si SecurityInsightsV1
 	if err := yaml.Unmarshal(data, &si); err != nil {
-		result.IsValid = false
-		result.Errors = append(result.Errors, fmt.Sprintf("Invalid YAML: %v", err))
+		addYAMLError(result, data, "Invalid YAML", err)
 		return result, nil
 	}
 
@@ -152,27 +546,29 @@ func (v *Validator) validateSecurityInsightsV1(data []byte) (*ValidationResult,
 	if si.Header.ProjectURL == "" {
 		result.IsValid = false
 		result.Errors = append(result.Errors, "Missing required field: header.project-url")
+	} else {
+		checkHTTPSURL(result, "header.project-url", si.Header.ProjectURL)
 	}
 
 	if si.Header.ExpirationDate == "" {
 		result.IsValid = false
 		result.Errors = append(result.Errors, "Missing required field: header.expiration-date")
 	} else {
-		// Validate expiration date format and check if expired
-		expirationDate, err := time.Parse(time.RFC3339, si.Header.ExpirationDate)
-		if err != nil {
-			result.Warnings = append(result.Warnings, "Invalid expiration-date format (should be RFC3339)")
-		} else if time.Now().After(expirationDate) {
-			result.Warnings = append(result.Warnings, "File has expired - please update expiration-date")
-		}
+		checkExpirationDate(result, si.Header.ExpirationDate)
 	}
 
 	if si.Header.LastUpdated == "" {
 		result.Warnings = append(result.Warnings, "Missing recommended field: header.last-updated")
+	} else {
+		checkFutureDate(result, "header.last-updated", si.Header.LastUpdated, time.RFC3339)
 	}
 
 	if si.Header.LastReviewed == "" {
 		result.Warnings = append(result.Warnings, "Missing recommended field: header.last-reviewed")
+	} else {
+		checkFutureDate(result, "header.last-reviewed", si.Header.LastReviewed, time.RFC3339)
+		history.checkReviewedAfterFirstCommit(result, si.Header.LastReviewed, time.RFC3339)
+		v.checkReviewDates(result, si.Header.LastUpdated, si.Header.LastReviewed, time.RFC3339)
 	}
 
 	if si.ProjectLifecycle.Status == "" {
@@ -205,6 +601,17 @@ func (v *Validator) validateSecurityInsightsV1(data []byte) (*ValidationResult,
 			if contact.Value == "" {
 				result.Warnings = append(result.Warnings,
 					fmt.Sprintf("Security contact %d missing value", i))
+			} else if contact.Type == "email" {
+				if err := ValidateEmail(contact.Value); err != nil {
+					result.IsValid = false
+					result.Errors = append(result.Errors, fmt.Sprintf("Security contact %d: %v", i, err))
+				} else {
+					v.checkEmailDomain(result, fmt.Sprintf("Security contact %d", i), contact.Value)
+				}
+			} else if contact.Type == "url" && !v.isSecurityReportingURL(contact.Value) {
+				result.Warnings = append(result.Warnings,
+					fmt.Sprintf("Security contact %d URL does not look like a security reporting page (expected one of %s in the path): %s",
+						i, strings.Join(v.securityURLKeywords, ", "), contact.Value))
 			}
 		}
 	}
@@ -214,18 +621,19 @@ func (v *Validator) validateSecurityInsightsV1(data []byte) (*ValidationResult,
 
 // validateSecurityInsightsV2 validates SECURITY-INSIGHTS.yml schema v2.0.0
 // Uses the official OpenSSF si-tooling library for schema validation
-func (v *Validator) validateSecurityInsightsV2(data []byte) (*ValidationResult, error) {
+func (v *Validator) validateSecurityInsightsV2(data []byte, history repoHistory) (*ValidationResult, error) {
 	result := &ValidationResult{
-		IsValid:  true,
-		Errors:   []string{},
-		Warnings: []string{},
+		ReportVersion: ReportVersion,
+		IsValid:       true,
+		Errors:        []string{},
+		Warnings:      []string{},
+		SchemaVersion: "2.0.0",
 	}
 
 	// Use official si-tooling structs for validation
 	var insights sitooling.SecurityInsights
 	if err := yaml.Unmarshal(data, &insights); err != nil {
-		result.IsValid = false
-		result.Errors = append(result.Errors, fmt.Sprintf("Schema validation failed: %v", err))
+		addYAMLError(result, data, "Schema validation failed", err)
 		return result, nil
 	}
 
@@ -238,19 +646,34 @@ func (v *Validator) validateSecurityInsightsV2(data []byte) (*ValidationResult,
 
 	// insights is now a validated sitooling.SecurityInsights struct
 	// Add our own custom checks on top of the official validation
+	v.checkSecurityInsightsV2(&insights, result, history)
+	result.Coverage = sectionCoverage(&insights)
+
+	return result, nil
+}
 
+// checkSecurityInsightsV2 applies the v2.0.0 field checks to parsed insights
+func (v *Validator) checkSecurityInsightsV2(insights *sitooling.SecurityInsights, result *ValidationResult, history repoHistory) {
 	// Check header fields
 	if insights.Header.LastUpdated == "" {
 		result.Warnings = append(result.Warnings, "Missing recommended field: header.last-updated")
+	} else {
+		checkFutureDate(result, "header.last-updated", insights.Header.LastUpdated, "2006-01-02")
 	}
 
 	if insights.Header.LastReviewed == "" {
 		result.Warnings = append(result.Warnings, "Missing recommended field: header.last-reviewed")
+	} else {
+		checkFutureDate(result, "header.last-reviewed", insights.Header.LastReviewed, "2006-01-02")
+		history.checkReviewedAfterFirstCommit(result, insights.Header.LastReviewed, "2006-01-02")
+		v.checkReviewDates(result, insights.Header.LastUpdated, insights.Header.LastReviewed, "2006-01-02")
 	}
 
 	if insights.Header.URL == "" {
 		result.IsValid = false
 		result.Errors = append(result.Errors, "Missing required field: header.url")
+	} else {
+		checkHTTPSURL(result, "header.url", insights.Header.URL)
 	}
 
 	// Check project section
@@ -266,17 +689,68 @@ func (v *Validator) validateSecurityInsightsV2(data []byte) (*ValidationResult,
 				result.Warnings = append(result.Warnings,
 					fmt.Sprintf("Administrator %d missing name", i))
 			}
+			// The generator gives the shared security email to the primary
+			// contact only, so other administrators may go without one
 			if admin.Email == "" {
+				if admin.Primary {
+					result.Warnings = append(result.Warnings,
+						fmt.Sprintf("Primary administrator %d missing email", i))
+				}
+			} else if err := ValidateEmail(admin.Email); err != nil {
 				result.Warnings = append(result.Warnings,
-					fmt.Sprintf("Administrator %d missing email", i))
+					fmt.Sprintf("Administrator %d: %v", i, err))
 			}
 		}
 	}
 
+	checkPlaceholderAffiliations(result, "Administrators", insights.Project.Administrators)
+	checkPlaceholderAffiliations(result, "Core team members", insights.Repository.CoreTeam)
+	checkDuplicateSocials(result, insights.Project.Administrators)
+	v.checkDuplicateEmails(result, "Administrators", insights.Project.Administrators)
+	v.checkDuplicateEmails(result, "Core team members", insights.Repository.CoreTeam)
+	for i, admin := range insights.Project.Administrators {
+		checkSocialURL(result, fmt.Sprintf("project.administrators[%d].social", i), admin.Social)
+	}
+	for i, member := range insights.Repository.CoreTeam {
+		checkSocialURL(result, fmt.Sprintf("repository.core-team[%d].social", i), member.Social)
+	}
+
+	if sameGeneratedContacts(insights.Project.Administrators, insights.Repository.CoreTeam) {
+		result.Warnings = append(result.Warnings,
+			"project.administrators and repository.core-team list the same generated placeholder entries; update them to reflect your actual administrators and core team")
+	}
+
+	// Check vulnerability reporting
+	vuln := insights.Project.Vulnerability
+	if vuln.Contact.Email != "" {
+		if err := ValidateEmail(vuln.Contact.Email); err != nil {
+			result.IsValid = false
+			result.Errors = append(result.Errors, fmt.Sprintf("project.vulnerability-reporting.contact: %v", err))
+		} else {
+			v.checkEmailDomain(result, "project.vulnerability-reporting.contact", vuln.Contact.Email)
+		}
+	}
+	if vuln.BugBountyAvailable && vuln.BugBountyProgram != "" {
+		v.checkURLReachable(result, "project.vulnerability-reporting.bug-bounty-program", vuln.BugBountyProgram)
+	}
+	if vuln.SecurityPolicy != "" {
+		checkHTTPSURL(result, "project.vulnerability-reporting.security-policy", vuln.SecurityPolicy)
+	} else if vuln.ReportsAccepted && insights.Repository.Status == "active" {
+		result.Warnings = append(result.Warnings,
+			"Missing recommended field: project.vulnerability-reporting.security-policy (link to your coordinated disclosure policy)")
+	}
+
 	// Check repository section
 	if insights.Repository.URL == "" {
 		result.IsValid = false
 		result.Errors = append(result.Errors, "Missing required field: repository.url")
+	} else {
+		checkHTTPSURL(result, "repository.url", insights.Repository.URL)
+	}
+	for i, point := range insights.Repository.Release.DistributionPoints {
+		if point.URI != "" {
+			checkHTTPSURL(result, fmt.Sprintf("repository.release.distribution-points[%d].uri", i), point.URI)
+		}
 	}
 
 	if insights.Repository.Status == "" {
@@ -298,5 +772,563 @@ func (v *Validator) validateSecurityInsightsV2(data []byte) (*ValidationResult,
 		}
 	}
 
-	return result, nil
+	checkLinkStyle(result, insights)
+
+	// An active project needs at least one way for outsiders to reach it
+	if insights.Repository.Status == "active" && !vuln.ReportsAccepted && !insights.Repository.AcceptsChangeRequest {
+		result.Warnings = append(result.Warnings,
+			"repository.status is active, but project.vulnerability-reporting.reports-accepted and repository.accepts-change-request are both false; "+
+				"a project that takes neither vulnerability reports nor changes is closed to the community, so accept one of them or update the status")
+	}
+}
+
+// checkFutureDate warns when a date field lies in the future beyond the
+// allowed clock skew, which usually indicates a typo or a wrong clock
+func checkFutureDate(result *ValidationResult, field, value, layout string) {
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return
+	}
+
+	if date.After(time.Now().Add(clockSkewTolerance)) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s is in the future: %s", field, value))
+	}
+}
+
+// checkExpirationDate warns when header.expiration-date is malformed, has
+// passed, is about to pass, or is so far out that it never prompts a renewal
+func checkExpirationDate(result *ValidationResult, value string) {
+	expirationDate, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		result.Warnings = append(result.Warnings, "Invalid expiration-date format (should be RFC3339)")
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case now.After(expirationDate):
+		result.Warnings = append(result.Warnings, "File has expired - please update expiration-date")
+	case now.AddDate(0, 0, expirationRenewDays).After(expirationDate):
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("header.expiration-date %s is within %d days; review the file and renew it", value, expirationRenewDays))
+	case now.AddDate(0, expirationMaxHorizonMonths, 0).Before(expirationDate):
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("header.expiration-date %s is more than %d months away; use a nearer date so the file gets reviewed", value, expirationMaxHorizonMonths))
+	}
+}
+
+// checkReviewDates warns when header.last-reviewed is later than
+// header.last-updated, since recording a review is itself an update, and when
+// the last review is older than the configured maximum age
+func (v *Validator) checkReviewDates(result *ValidationResult, updated, reviewed, layout string) {
+	reviewedAt, err := time.Parse(layout, reviewed)
+	if err != nil {
+		return
+	}
+
+	if updatedAt, err := time.Parse(layout, updated); err == nil && reviewedAt.After(updatedAt) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("header.last-reviewed %s is after header.last-updated %s; update last-updated when recording a review", reviewed, updated))
+	}
+
+	if v.reviewMaxAgeMonths > 0 && reviewedAt.AddDate(0, v.reviewMaxAgeMonths, 0).Before(time.Now()) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("header.last-reviewed %s is more than %d months ago; review the file and update the date ('baseline-init fix' sets it to today)",
+				reviewed, v.reviewMaxAgeMonths))
+	}
+}
+
+// checkReviewedAfterFirstCommit reports an error when last-reviewed predates
+// the repository's first commit. A date-only review is treated as covering
+// the whole day, and clock skew is allowed for, so timezones cannot trigger it.
+// In a shallow clone it leaves a note that the check was skipped instead.
+func (h repoHistory) checkReviewedAfterFirstCommit(result *ValidationResult, value, layout string) {
+	if h.shallow {
+		result.Notes = append(result.Notes,
+			"header.last-reviewed was not compared with the repository's first commit: the repository is a shallow clone")
+		return
+	}
+	if h.firstCommit.IsZero() {
+		return
+	}
+
+	reviewed, err := time.Parse(layout, value)
+	if err != nil {
+		return
+	}
+	if layout == "2006-01-02" {
+		reviewed = reviewed.Add(24 * time.Hour)
+	}
+
+	if reviewed.Add(clockSkewTolerance).Before(h.firstCommit) {
+		result.IsValid = false
+		result.Errors = append(result.Errors,
+			fmt.Sprintf("header.last-reviewed %s is before the repository's first commit on %s",
+				value, h.firstCommit.Format("2006-01-02")))
+	}
+}
+
+// checkHTTPSURL reports an error when value is not an absolute URL, and a
+// warning when it has no host or does not use https. It returns the parsed
+// URL, or nil when value is not a URL.
+func checkHTTPSURL(result *ValidationResult, field, value string) *url.URL {
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() {
+		result.IsValid = false
+		result.Errors = append(result.Errors, fmt.Sprintf("Invalid URL in %s: %s", field, value))
+		return nil
+	}
+
+	if u.Host == "" {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s has no host: %s", field, value))
+	}
+	if u.Scheme != "https" {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s should use https: %s", field, value))
+	}
+	return u
+}
+
+// checkLinkStyle warns when links to files in the repository mix relative
+// paths and absolute URLs, or when an absolute license URL on the same host
+// as repository.url points into a different repository. Either way some
+// links stop resolving once the file is rendered somewhere else, such as on
+// a mirror or in a local checkout.
+func checkLinkStyle(result *ValidationResult, insights *sitooling.SecurityInsights) {
+	repo := insights.Repository
+	links := []struct{ field, value string }{
+		{"repository.license.url", repo.License.URL},
+		{"repository.documentation.contributing-guide", repo.Documentation.Contributing},
+		{"repository.documentation.dependency-management-policy", repo.Documentation.DependencyManagement},
+		{"repository.documentation.governance", repo.Documentation.Governance},
+		{"repository.documentation.review-policy", repo.Documentation.ReviewPolicy},
+		{"repository.documentation.security-policy", repo.Documentation.SecurityPolicy},
+	}
+
+	var relative, absolute []string
+	for _, link := range links {
+		if link.value == "" {
+			continue
+		}
+		if isAbsoluteURL(link.value) {
+			absolute = append(absolute, link.field)
+		} else {
+			relative = append(relative, link.field)
+		}
+	}
+	if len(relative) > 0 && len(absolute) > 0 {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("Links to repository files mix relative paths (%s) and absolute URLs (%s); relative paths resolve against wherever the file is rendered, so use one style throughout",
+				strings.Join(relative, ", "), strings.Join(absolute, ", ")))
+	}
+
+	if !isAbsoluteURL(repo.License.URL) || !isAbsoluteURL(repo.URL) {
+		return
+	}
+	license := gitutil.NormalizeURL(repo.License.URL)
+	repoURL := gitutil.NormalizeURL(repo.URL)
+	sameHost := strings.SplitN(license, "/", 2)[0] == strings.SplitN(repoURL, "/", 2)[0]
+	if sameHost && license != repoURL && !strings.HasPrefix(license, repoURL+"/") {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("repository.license.url (%s) points outside repository.url (%s); link the license in this repository, or use a relative path",
+				repo.License.URL, repo.URL))
+	}
+}
+
+// isAbsoluteURL reports whether value has a scheme and host rather than
+// being a path relative to the document
+func isAbsoluteURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// PlaceholderAffiliation is the affiliation the generator writes when none is
+// configured, so a contact still carrying it has not been edited
+const PlaceholderAffiliation = "Organization"
+
+// checkPlaceholderAffiliations warns about contacts whose affiliation is
+// still the generator's placeholder
+func checkPlaceholderAffiliations(result *ValidationResult, role string, contacts []sitooling.Contact) {
+	var indexes []int
+	for i, contact := range contacts {
+		if contact.Affiliation == PlaceholderAffiliation {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return
+	}
+
+	result.Warnings = append(result.Warnings,
+		fmt.Sprintf("%s with the placeholder affiliation %q: %s; set their actual organization or remove the field",
+			role, PlaceholderAffiliation, contactNames(contacts, indexes)))
+}
+
+// contactNames lists the contacts at indexes by name, falling back to their
+// position for unnamed entries
+func contactNames(contacts []sitooling.Contact, indexes []int) string {
+	names := make([]string, len(indexes))
+	for i, idx := range indexes {
+		names[i] = contacts[idx].Name
+		if names[i] == "" {
+			names[i] = fmt.Sprintf("entry %d", idx)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// sameGeneratedContacts reports whether two contact lists are identical and
+// consist only of unedited generator output
+func sameGeneratedContacts(a, b []sitooling.Contact) bool {
+	if len(a) == 0 || len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] || a[i].Affiliation != PlaceholderAffiliation {
+			return false
+		}
+	}
+	return true
+}
+
+// isSecurityReportingURL reports whether a URL's path contains one of the
+// configured security reporting keywords
+func (v *Validator) isSecurityReportingURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	path := strings.ToLower(u.Path + "?" + u.RawQuery)
+	for _, keyword := range v.securityURLKeywords {
+		if strings.Contains(path, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDuplicateSocials warns when several administrators share the same
+// social URL, which is usually a copy-paste error
+func checkDuplicateSocials(result *ValidationResult, admins []sitooling.Contact) {
+	keys := make([]string, len(admins))
+	for i, admin := range admins {
+		keys[i] = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(admin.Social)), "/")
+	}
+
+	for _, indexes := range duplicateGroups(keys) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("Administrators %s share the same social URL: %s",
+				joinIndexes(indexes), admins[indexes[0]].Social))
+	}
+}
+
+// checkDuplicateEmails warns when several entries of a contact list share an
+// email address once normalized, so "Sec@Acme.com" and "sec@acme.com" count
+// as one contact. Entries with the placeholder affiliation are skipped: files
+// from older generator versions gave every contact the security email.
+func (v *Validator) checkDuplicateEmails(result *ValidationResult, role string, contacts []sitooling.Contact) {
+	keys := make([]string, len(contacts))
+	for i, contact := range contacts {
+		if contact.Affiliation != PlaceholderAffiliation {
+			keys[i] = NormalizeEmail(contact.Email, v.stripPlusTags)
+		}
+	}
+
+	for _, indexes := range duplicateGroups(keys) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s %s share the same email: %s",
+				role, joinIndexes(indexes), contacts[indexes[0]].Email))
+	}
+}
+
+// NormalizeEmail reduces an address to the form used to spot duplicate
+// contacts. Case is ignored: domains are case-insensitive, and providers
+// treat local parts the same way in practice. With stripPlus, a "+tag"
+// sub-address is dropped from the local part; only some providers deliver
+// those to the base mailbox, so it is opt-in.
+func NormalizeEmail(email string, stripPlus bool) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return email
+	}
+	if stripPlus {
+		local, _, _ = strings.Cut(local, "+")
+	}
+	return local + "@" + domain
+}
+
+// ValidateEmail checks that email is a single bare address such as
+// security@example.com. Display names ("Sec <sec@example.com>") are rejected
+// since the schema expects just the address.
+func ValidateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("invalid email address %q", email)
+	}
+	if addr.Name != "" || addr.Address != email {
+		return fmt.Errorf("invalid email address %q: expected a bare address such as %s", email, addr.Address)
+	}
+	return nil
+}
+
+// duplicateGroups returns the indexes of keys that occur more than once,
+// grouped by key in order of first appearance. Empty keys are ignored.
+func duplicateGroups(keys []string) [][]int {
+	seen := map[string][]int{}
+	order := []string{}
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			order = append(order, key)
+		}
+		seen[key] = append(seen[key], i)
+	}
+
+	var groups [][]int
+	for _, key := range order {
+		if len(seen[key]) > 1 {
+			groups = append(groups, seen[key])
+		}
+	}
+	return groups
+}
+
+// joinIndexes formats list positions as "0, 2"
+func joinIndexes(indexes []int) string {
+	entries := make([]string, len(indexes))
+	for i, idx := range indexes {
+		entries[i] = strconv.Itoa(idx)
+	}
+	return strings.Join(entries, ", ")
+}
+
+// checkSocialURL checks a contact's social link like checkHTTPSURL, and warns
+// when it is on a recognized platform (GitHub, GitLab or a Mastodon-style
+// instance) but does not point at a single user profile. Links to other
+// sites are accepted as-is.
+func checkSocialURL(result *ValidationResult, field, value string) {
+	if value == "" {
+		return
+	}
+
+	u := checkHTTPSURL(result, field, value)
+	if u == nil || u.Host == "" {
+		return
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch host := strings.ToLower(u.Host); {
+	case host == "github.com" || host == "www.github.com":
+		if len(segments) != 1 || segments[0] == "" {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("%s does not point to a GitHub profile: %s", field, value))
+		}
+	case host == "gitlab.com" || host == "www.gitlab.com":
+		if len(segments) != 1 || segments[0] == "" {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("%s does not point to a GitLab profile: %s", field, value))
+		}
+	case strings.HasPrefix(segments[0], "@"):
+		// Mastodon and other fediverse profiles: https://host/@user
+		if len(segments) != 1 || len(segments[0]) < 2 {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("%s does not point to a Mastodon profile: %s", field, value))
+		}
+	}
+}
+
+// checkDocumentLimits rejects documents that exceed the size, nesting or
+// alias expansion limits. Parsing into a yaml.Node does not expand aliases,
+// so the walk below bounds the work even for billion-laughs style input.
+// Syntax errors are left to the caller's own decoding, which reports them
+// with their position.
+func checkDocumentLimits(data []byte) error {
+	if len(data) > maxDocumentSize {
+		return fmt.Errorf("Document too large: %d bytes (limit %d)", len(data), maxDocumentSize)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil
+	}
+
+	budget := maxYAMLNodes
+	return walkYAMLNode(&root, 0, &budget)
+}
+
+// yamlLinePattern matches the "line N: message" form yaml.v3 uses for both
+// syntax errors and each entry of a TypeError
+var yamlLinePattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// addYAMLError marks result invalid and records a YAML decoding error, one
+// entry per offending value, both in YAMLErrors and as prefixed Errors
+func addYAMLError(result *ValidationResult, data []byte, prefix string, err error) {
+	result.IsValid = false
+	for _, e := range yamlErrors(data, err) {
+		result.YAMLErrors = append(result.YAMLErrors, e)
+		result.Errors = append(result.Errors, prefix+": "+e.String())
+	}
+}
+
+// yamlErrors splits a yaml.v3 error into located errors. A TypeError holds
+// one message per value that did not fit, and as the document itself parsed,
+// the column is that of the value on the reported line. Messages without a
+// line are kept as they are.
+func yamlErrors(data []byte, err error) []YAMLError {
+	messages := []string{err.Error()}
+	var root *yaml.Node
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+		var doc yaml.Node
+		if yaml.Unmarshal(data, &doc) == nil {
+			root = &doc
+		}
+	}
+
+	located := make([]YAMLError, 0, len(messages))
+	for _, msg := range messages {
+		m := yamlLinePattern.FindStringSubmatch(msg)
+		if m == nil {
+			located = append(located, YAMLError{Message: strings.TrimPrefix(msg, "yaml: ")})
+			continue
+		}
+		line, _ := strconv.Atoi(m[1])
+		located = append(located, YAMLError{Line: line, Column: valueColumn(root, line), Message: m[2]})
+	}
+	return located
+}
+
+// valueColumn returns the column of the value given on line, which is what
+// a TypeError for that line is about, or zero if there is none. Only values
+// on the same line as their key count, as a block value starts below it.
+func valueColumn(node *yaml.Node, line int) int {
+	if node == nil {
+		return 0
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Line == line && value.Line == line {
+				return value.Column
+			}
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Line == line && item.Kind == yaml.ScalarNode {
+				return item.Column
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if column := valueColumn(child, line); column > 0 {
+			return column
+		}
+	}
+	return 0
+}
+
+// walkYAMLNode visits node and its children, following aliases, and fails
+// once the nesting depth or the remaining node budget is exceeded
+func walkYAMLNode(node *yaml.Node, depth int, budget *int) error {
+	if node == nil {
+		return nil
+	}
+	if depth > maxNestingDepth {
+		return fmt.Errorf("Document nested too deeply (limit %d levels)", maxNestingDepth)
+	}
+
+	*budget--
+	if *budget < 0 {
+		return fmt.Errorf("Document expands to too many nodes (limit %d); check for excessive aliases", maxYAMLNodes)
+	}
+
+	if node.Kind == yaml.AliasNode {
+		return walkYAMLNode(node.Alias, depth+1, budget)
+	}
+
+	for _, child := range node.Content {
+		if err := walkYAMLNode(child, depth+1, budget); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEmailDomain warns when network checks are enabled and the domain of a
+// contact email has no mail servers, which usually means a typo or a dead
+// domain. Lookups that fail for other reasons, such as timeouts, are ignored.
+func (v *Validator) checkEmailDomain(result *ValidationResult, field, email string) {
+	if !v.networkChecks {
+		return
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return
+	}
+	domain := email[at+1:]
+
+	ctx, cancel := context.WithTimeout(context.Background(), mxLookupTimeout)
+	defer cancel()
+
+	records, err := v.lookupMX(ctx, domain)
+	var dnsErr *net.DNSError
+	if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+		return
+	}
+
+	// A lone "." record is a null MX (RFC 7505): the domain accepts no mail
+	if len(records) == 0 || (len(records) == 1 && records[0].Host == ".") {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s email domain %s has no MX records; reports may not be deliverable", field, domain))
+	}
+}
+
+// checkURLReachable warns when network checks are enabled and an advertised
+// page does not respond successfully. Servers that reject HEAD are retried
+// with GET before the page is reported.
+func (v *Validator) checkURLReachable(result *ValidationResult, field, rawURL string) {
+	if !v.networkChecks {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), urlCheckTimeout)
+	defer cancel()
+
+	status, err := requestStatus(ctx, http.MethodHead, rawURL)
+	if err == nil && status == http.StatusMethodNotAllowed {
+		status, err = requestStatus(ctx, http.MethodGet, rawURL)
+	}
+
+	switch {
+	case err != nil:
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s is advertised but could not be reached: %s (%v)", field, rawURL, err))
+	case status >= 400:
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%s is advertised but responded with HTTP %d: %s", field, status, rawURL))
+	}
+}
+
+// requestStatus sends a bodiless request and returns the response status
+func requestStatus(ctx context.Context, method, rawURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
 }